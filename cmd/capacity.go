@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/service"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/debug"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/github"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+var capacityFormat string
+
+var capacityCmd = &cobra.Command{
+	Use:   "capacity",
+	Short: "Print the runner capacity and queued jobs by label, highest pressure first",
+	Args:  cobra.NoArgs,
+	RunE:  runCapacity,
+}
+
+func init() {
+	addScopeFlags(capacityCmd.Flags())
+	capacityCmd.Flags().StringVar(&capacityFormat, "format", formatTable, "Output format (table, json or csv)")
+
+	rootCmd.AddCommand(capacityCmd)
+}
+
+// labelCapacityOutput is the JSON representation of a label capacity
+type labelCapacityOutput struct {
+	Label                   string `json:"label"`
+	Total                   int    `json:"total"`
+	Idle                    int    `json:"idle"`
+	Active                  int    `json:"active"`
+	Offline                 int    `json:"offline"`
+	QueuedJobs              int    `json:"queued_jobs"`
+	LongestQueueWaitSeconds int64  `json:"longest_queue_wait_seconds"`
+	Pressure                int    `json:"pressure"`
+}
+
+// newLabelCapacityOutput converts a label capacity into its JSON representation
+func newLabelCapacityOutput(c *value_object.LabelCapacity) labelCapacityOutput {
+	return labelCapacityOutput{
+		Label:                   c.Label,
+		Total:                   c.Total,
+		Idle:                    c.Idle,
+		Active:                  c.Active,
+		Offline:                 c.Offline,
+		QueuedJobs:              c.QueuedJobs,
+		LongestQueueWaitSeconds: int64(c.LongestQueueWait.Seconds()),
+		Pressure:                c.Pressure(),
+	}
+}
+
+func runCapacity(cmd *cobra.Command, _ []string) error {
+	switch capacityFormat {
+	case formatTable, formatJSON, formatCSV:
+	default:
+		return fmt.Errorf("invalid --format value %q (use table, json or csv)", capacityFormat)
+	}
+
+	scope, err := newRunnerScope()
+	if err != nil {
+		return err
+	}
+	if scope.Enterprise != "" {
+		return fmt.Errorf("capacity does not support enterprise runners, use --org or --repo")
+	}
+
+	var runnerRepo repository.RunnerRepository
+	var jobRepo repository.JobRepository
+	var timeProvider repository.TimeProvider
+	if scopeDebugPath != "" {
+		data, err := debug.LoadDebugData(scopeDebugPath)
+		if err != nil {
+			return fmt.Errorf("failed to load debug data: %w", err)
+		}
+		runnerRepo = debug.NewRunnerRepository(data)
+		jobRepo = debug.NewJobRepository(data)
+		timeProvider = debug.NewTimeProvider(data)
+	} else {
+		runnerRepo, err = github.NewRunnerRepository()
+		if err != nil {
+			return fmt.Errorf("failed to create GitHub client: %w", err)
+		}
+		jobRepo, err = github.NewJobRepository()
+		if err != nil {
+			return fmt.Errorf("failed to create GitHub job client: %w", err)
+		}
+		timeProvider = github.NewTimeProvider()
+	}
+
+	data, err := usecase.NewRunnerMonitor(runnerRepo, jobRepo, timeProvider).Execute(cmd.Context(), scope.Owner, scope.Repo, scope.Org)
+	if err != nil {
+		return err
+	}
+	capacities := service.SummarizeLabelCapacity(data.Runners, data.Jobs, data.CurrentTime)
+
+	switch capacityFormat {
+	case formatJSON:
+		output := make([]labelCapacityOutput, 0, len(capacities))
+		for _, c := range capacities {
+			output = append(output, newLabelCapacityOutput(c))
+		}
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	case formatCSV:
+		return printCapacityCSV(cmd.OutOrStdout(), capacities)
+	default:
+		return printCapacityTable(cmd.OutOrStdout(), capacities)
+	}
+}
+
+// printCapacityTable writes the label capacities as an aligned table
+func printCapacityTable(w io.Writer, capacities []*value_object.LabelCapacity) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "LABEL\tTOTAL\tIDLE\tACTIVE\tOFFLINE\tQUEUED\tLONGEST WAIT\tPRESSURE")
+	for _, c := range capacities {
+		wait := "-"
+		if c.QueuedJobs > 0 {
+			wait = c.LongestQueueWait.Round(time.Second).String()
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%s\t%d\n",
+			c.Label, c.Total, c.Idle, c.Active, c.Offline, c.QueuedJobs, wait, c.Pressure())
+	}
+	return tw.Flush()
+}
+
+// printCapacityCSV writes the label capacities as CSV with a header row
+func printCapacityCSV(w io.Writer, capacities []*value_object.LabelCapacity) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"label", "total", "idle", "active", "offline", "queued_jobs", "longest_queue_wait_seconds", "pressure"})
+	for _, c := range capacities {
+		_ = cw.Write([]string{
+			c.Label,
+			strconv.Itoa(c.Total),
+			strconv.Itoa(c.Idle),
+			strconv.Itoa(c.Active),
+			strconv.Itoa(c.Offline),
+			strconv.Itoa(c.QueuedJobs),
+			strconv.FormatInt(int64(c.LongestQueueWait.Seconds()), 10),
+			strconv.Itoa(c.Pressure()),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
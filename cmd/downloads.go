@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/debug"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/github"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// Output formats of the downloads subcommand
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+var (
+	downloadOS     string
+	downloadArch   string
+	downloadFormat string
+	verifyPath     string
+)
+
+var downloadsCmd = &cobra.Command{
+	Use:   "downloads",
+	Short: "List runner application downloads and verify downloaded archives",
+	Args:  cobra.NoArgs,
+	RunE:  runDownloads,
+}
+
+func init() {
+	addScopeFlags(downloadsCmd.Flags())
+	downloadsCmd.Flags().StringVar(&downloadOS, "os", "", "Only list packages for this operating system (linux, osx, win)")
+	downloadsCmd.Flags().StringVar(&downloadArch, "arch", "", "Only list packages for this architecture (x64, arm, arm64)")
+	downloadsCmd.Flags().StringVar(&downloadFormat, "format", formatTable, "Output format (table, json or csv)")
+	downloadsCmd.Flags().StringVar(&verifyPath, "verify", "", "Verify a downloaded archive against the advertised SHA-256 checksum")
+
+	rootCmd.AddCommand(downloadsCmd)
+}
+
+// runnerDownloadOutput is the JSON representation of a runner application package
+type runnerDownloadOutput struct {
+	OS             string `json:"os"`
+	Architecture   string `json:"architecture"`
+	DownloadURL    string `json:"download_url"`
+	Filename       string `json:"filename"`
+	SHA256Checksum string `json:"sha256_checksum"`
+}
+
+func runDownloads(cmd *cobra.Command, _ []string) error {
+	switch downloadFormat {
+	case formatTable, formatJSON, formatCSV:
+	default:
+		return fmt.Errorf("invalid --format value %q (use table, json or csv)", downloadFormat)
+	}
+
+	scope, err := newRunnerScope()
+	if err != nil {
+		return err
+	}
+
+	var downloadRepository repository.DownloadRepository
+	if scopeDebugPath != "" {
+		data, err := debug.LoadDebugData(scopeDebugPath)
+		if err != nil {
+			return fmt.Errorf("failed to load debug data: %w", err)
+		}
+		downloadRepository = debug.NewDownloadRepository(data)
+	} else {
+		downloadRepository, err = github.NewDownloadRepository()
+		if err != nil {
+			return fmt.Errorf("failed to create GitHub client: %w", err)
+		}
+	}
+
+	downloads, err := usecase.NewRunnerDownloads(downloadRepository).List(cmd.Context(), scope, downloadOS, downloadArch)
+	if err != nil {
+		return err
+	}
+
+	if verifyPath != "" {
+		return verifyDownload(cmd.OutOrStdout(), downloads)
+	}
+
+	switch downloadFormat {
+	case formatJSON:
+		output := make([]runnerDownloadOutput, 0, len(downloads))
+		for _, d := range downloads {
+			output = append(output, runnerDownloadOutput(*d))
+		}
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	case formatCSV:
+		return printDownloadsCSV(cmd.OutOrStdout(), downloads)
+	default:
+		return printDownloadsTable(cmd.OutOrStdout(), downloads)
+	}
+}
+
+// verifyDownload checks the archive at verifyPath and reports the matching package
+func verifyDownload(w io.Writer, downloads []*value_object.RunnerDownload) error {
+	file, err := os.Open(verifyPath)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	download, err := usecase.VerifyArchive(downloads, verifyPath, file)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "OK: %s matches %s (%s/%s)\n", verifyPath, download.Filename, download.OS, download.Architecture)
+	return err
+}
+
+// printDownloadsTable writes the downloads as an aligned table
+func printDownloadsTable(w io.Writer, downloads []*value_object.RunnerDownload) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "OS\tARCH\tFILENAME\tSHA-256\tURL")
+	for _, d := range downloads {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", d.OS, d.Architecture, d.Filename, d.SHA256Checksum, d.DownloadURL)
+	}
+	return tw.Flush()
+}
+
+// printDownloadsCSV writes the downloads as CSV with a header row
+func printDownloadsCSV(w io.Writer, downloads []*value_object.RunnerDownload) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"os", "architecture", "filename", "sha256_checksum", "download_url"})
+	for _, d := range downloads {
+		_ = cw.Write([]string{d.OS, d.Architecture, d.Filename, d.SHA256Checksum, d.DownloadURL})
+	}
+	cw.Flush()
+	return cw.Error()
+}
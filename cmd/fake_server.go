@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/fakeserver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scenarioPath string
+	listenAddr   string
+)
+
+var fakeServerCmd = &cobra.Command{
+	Use:   "fake-server",
+	Short: "Serve a fake GitHub Actions API for local development",
+	Long: `Serve the runners, workflow runs and jobs endpoints of the GitHub Actions API
+from a scenario file, with pagination, rate-limit headers and error injection.
+Point the monitor at it with --api-url.`,
+	Args: cobra.NoArgs,
+	RunE: runFakeServer,
+}
+
+func init() {
+	fakeServerCmd.Flags().StringVar(&scenarioPath, "scenario", "", "Path to JSON scenario file (required)")
+	fakeServerCmd.Flags().StringVar(&listenAddr, "addr", "127.0.0.1:8080", "Address to listen on")
+	_ = fakeServerCmd.MarkFlagRequired("scenario")
+
+	rootCmd.AddCommand(fakeServerCmd)
+}
+
+func runFakeServer(cmd *cobra.Command, _ []string) error {
+	scenario, err := fakeserver.LoadScenario(scenarioPath)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Fake GitHub API listening on http://%s\n", listenAddr)
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Run: gh runner-monitor --api-url http://%s --repo owner/repo\n", listenAddr)
+	return http.ListenAndServe(listenAddr, fakeserver.New(scenario))
+}
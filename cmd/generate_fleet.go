@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/debug"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fleetOptions debug.FleetOptions
+	fleetOutput  string
+)
+
+var generateFleetCmd = &cobra.Command{
+	Use:   "generate-fleet",
+	Short: "Generate debug data with a synthetic fleet of runners and jobs",
+	Long: `Generate a debug data file of arbitrary size with realistic label distributions,
+operating system mix and job assignments, for load-testing with --debug.`,
+	Args: cobra.NoArgs,
+	RunE: runGenerateFleet,
+}
+
+func init() {
+	generateFleetCmd.Flags().IntVar(&fleetOptions.Runners, "runners", 1000, "Number of runners")
+	generateFleetCmd.Flags().IntVar(&fleetOptions.Jobs, "jobs", 400, "Number of active jobs")
+	generateFleetCmd.Flags().Float64Var(&fleetOptions.OfflineRatio, "offline-ratio", 0.05, "Share of offline runners")
+	generateFleetCmd.Flags().Float64Var(&fleetOptions.QueuedRatio, "queued-ratio", 0.2, "Share of jobs that stay queued")
+	generateFleetCmd.Flags().Uint64Var(&fleetOptions.Seed, "seed", 1, "Seed for reproducible output")
+	generateFleetCmd.Flags().StringVarP(&fleetOutput, "output", "o", "", "Output file (default: stdout)")
+
+	rootCmd.AddCommand(generateFleetCmd)
+}
+
+func runGenerateFleet(cmd *cobra.Command, _ []string) error {
+	if fleetOptions.Runners < 0 || fleetOptions.Jobs < 0 {
+		return fmt.Errorf("--runners and --jobs must not be negative")
+	}
+
+	fleetOptions.CurrentTime = time.Now().UTC().Truncate(time.Second)
+	data := debug.GenerateFleet(fleetOptions)
+
+	out := cmd.OutOrStdout()
+	if fleetOutput != "" {
+		file, err := os.Create(fleetOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		out = file
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(data); err != nil {
+		return fmt.Errorf("failed to write fleet: %w", err)
+	}
+	return nil
+}
@@ -3,11 +3,16 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/config"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/debug"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/github"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/notifier"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/presentation"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
 	tea "github.com/charmbracelet/bubbletea"
@@ -20,8 +25,32 @@ var (
 	repo      string
 	interval  int
 	debugPath string
+
+	historySize int
+	showHistory bool
+	groupBy     string
+
+	goneRetention time.Duration
+
+	configPath  string
+	longRunning time.Duration
+	longQueued  time.Duration
+
+	bell      bool
+	oscNotify string
+	notifyOn  []string
+
+	readOnly bool
+
+	recordDir   string
+	replaySpeed float64
+
+	apiURL string
 )
 
+// alertFlushTimeout bounds how long exiting waits for alert notifications still being delivered
+const alertFlushTimeout = 5 * time.Second
+
 var rootCmd = &cobra.Command{
 	Use:   "gh-runner-monitor",
 	Short: "Monitor GitHub Actions self-hosted runners in real-time",
@@ -44,24 +73,89 @@ func init() {
 	rootCmd.Flags().StringVar(&repo, "repo", "", "Monitor runners for a specific repository (owner/repo)")
 	rootCmd.Flags().IntVar(&interval, "interval", 5, "Update interval in seconds")
 	rootCmd.Flags().StringVar(&debugPath, "debug", "", "Debug mode: path to JSON file with mock runner data")
+	rootCmd.Flags().IntVar(&historySize, "history", presentation.DefaultHistorySize, "Number of refreshes kept for utilization sparklines")
+	rootCmd.Flags().BoolVar(&showHistory, "sparkline", false, "Show utilization sparklines on startup (toggle with 's')")
+	rootCmd.Flags().StringVar(&groupBy, "group-by", "", "Group runners into pools on startup: prefix (scale set / name prefix) or labels (toggle with 'P')")
+	rootCmd.Flags().DurationVar(&goneRetention, "gone-retention", value_object.DefaultGoneRetention, "Keep runners that disappeared, such as ephemeral runners, in the recently gone section for this duration")
+	rootCmd.Flags().StringVar(&configPath, "config", "", "Path to JSON configuration file")
+	rootCmd.Flags().DurationVar(&longRunning, "long-running", 0, "Flag jobs running longer than this duration (overrides config, default 1h)")
+	rootCmd.Flags().DurationVar(&longQueued, "long-queued", 0, "Flag jobs queued longer than this duration (overrides config, default 15m)")
+	rootCmd.Flags().BoolVar(&readOnly, "read-only", false, "Disable actions that modify runners, jobs or workflow runs")
+	rootCmd.Flags().StringVar(&apiURL, "api-url", "", "Send API requests to this URL instead of the authenticated GitHub host (e.g. a fake-server)")
+	rootCmd.Flags().StringVar(&recordDir, "record", "", "Record every snapshot into a session file in this directory (replay with --debug)")
+	rootCmd.Flags().Float64Var(&replaySpeed, "replay-speed", 0, "Replay a session file at this multiple of the recorded speed (default: one snapshot per refresh)")
+	rootCmd.Flags().BoolVar(&bell, "bell", false, "Ring the terminal bell on runner and job transitions")
+	rootCmd.Flags().StringVar(&oscNotify, "osc-notify", "", "Send desktop notifications using an OSC escape sequence (9 or 777)")
+	rootCmd.Flags().StringSliceVar(&notifyOn, "notify-on", eventTypeNames(presentation.DefaultNotificationEvents),
+		fmt.Sprintf("Transitions that trigger notifications (%s)", strings.Join(eventTypeNames(value_object.AllEventTypes), ", ")))
+}
+
+// eventTypeNames returns the names of the event types as they are passed to --notify-on
+func eventTypeNames(types []value_object.EventType) []string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, string(t))
+	}
+	return names
 }
 
 func runMonitor(_ *cobra.Command, _ []string) error {
+	notifications, err := newNotificationOptions()
+	if err != nil {
+		return err
+	}
+
+	if goneRetention <= 0 {
+		return fmt.Errorf("invalid --gone-retention value %s (must be positive)", goneRetention)
+	}
+
+	if replaySpeed < 0 {
+		return fmt.Errorf("invalid --replay-speed value %g (must not be negative)", replaySpeed)
+	}
+	if replaySpeed != 0 && (debugPath == "" || !debug.IsSessionFile(debugPath)) {
+		return fmt.Errorf("--replay-speed requires a session file passed with --debug")
+	}
+
+	poolGrouping := value_object.PoolGrouping(groupBy)
+	if poolGrouping != value_object.PoolGroupingNone && !slices.Contains(value_object.PoolGroupings, poolGrouping) {
+		return fmt.Errorf("invalid --group-by value %q (use prefix or labels)", groupBy)
+	}
+
 	var runnerRepo repository.RunnerRepository
 	var jobRepo repository.JobRepository
 	var timeProvider repository.TimeProvider
-	var err error
+	var player *debug.Player
 
 	// Check if debug mode is enabled
 	if debugPath != "" {
 		// Use debug repositories with JSON data
-		data, err := debug.LoadDebugData(debugPath)
-		if err != nil {
-			return fmt.Errorf("failed to load debug data: %w", err)
+		var data *debug.Data
+		if debug.IsSessionFile(debugPath) {
+			// Play back the recorded timeline of a session file
+			snapshots, err := debug.LoadSession(debugPath)
+			if err != nil {
+				return fmt.Errorf("failed to load session: %w", err)
+			}
+			player = debug.NewPlayer(snapshots, replaySpeed)
+			data = player.Data()
+		} else {
+			data, err = debug.LoadDebugData(debugPath)
+			if err != nil {
+				return fmt.Errorf("failed to load debug data: %w", err)
+			}
 		}
 		runnerRepo = debug.NewRunnerRepository(data)
 		jobRepo = debug.NewJobRepository(data)
 		timeProvider = debug.NewTimeProvider(data)
+	} else if apiURL != "" {
+		// Use the GitHub repositories against another API, such as the fake server
+		restClient, err := github.NewRESTClient(apiURL)
+		if err != nil {
+			return err
+		}
+		runnerRepo = github.NewRunnerRepositoryWithClient(restClient)
+		jobRepo = github.NewJobRepositoryWithClient(restClient)
+		timeProvider = github.NewTimeProvider()
 	} else {
 		// Create infrastructure layer (GitHub client)
 		runnerRepo, err = github.NewRunnerRepository()
@@ -87,8 +181,8 @@ func runMonitor(_ *cobra.Command, _ []string) error {
 		owner = parts[0]
 		repoName = parts[1]
 	} else {
-		// In debug mode, we don't need to fetch current repository
-		if debugPath != "" {
+		// In debug mode or against a custom API, we don't need to fetch current repository
+		if debugPath != "" || apiURL != "" {
 			owner = "owner"
 			repoName = "repo"
 		} else {
@@ -101,16 +195,125 @@ func runMonitor(_ *cobra.Command, _ []string) error {
 		}
 	}
 
+	cfg := &config.Config{}
+	if configPath != "" {
+		cfg, err = config.Load(configPath)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+	}
+
+	thresholds := cfg.JobThresholds()
+	if longRunning > 0 {
+		thresholds.LongRunning = longRunning
+	}
+	if longQueued > 0 {
+		thresholds.LongQueued = longQueued
+	}
+
 	// Create use case with dependencies
 	monitorUseCase := usecase.NewRunnerMonitor(runnerRepo, jobRepo, timeProvider)
+	monitorUseCase.SetJobThresholds(thresholds)
+	monitorUseCase.SetGoneRetention(goneRetention)
+
+	rules, err := cfg.AlertRules()
+	if err != nil {
+		return fmt.Errorf("invalid alert configuration: %w", err)
+	}
+	var alerts *usecase.AlertMonitor
+	if len(rules) > 0 {
+		notifiers, err := newNotifiers(cfg)
+		if err != nil {
+			return fmt.Errorf("invalid alert configuration: %w", err)
+		}
+		alerts = usecase.NewAlertMonitor(rules, notifiers)
+		monitorUseCase.AddObserver(alerts)
+	}
+
+	if recordDir != "" {
+		recorder, err := debug.NewSessionRecorder(recordDir)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = recorder.Close()
+			_, _ = fmt.Fprintf(os.Stderr, "Session recorded to %s\n", recorder.Path())
+		}()
+		monitorUseCase.AddObserver(recorder)
+	}
+
+	opts := presentation.Options{
+		HistorySize:   historySize,
+		ShowHistory:   showHistory,
+		Notifications: notifications,
+		RunnerManager: usecase.NewRunnerManager(runnerRepo, readOnly),
+		JobManager:    usecase.NewJobManager(jobRepo, readOnly),
+		PoolGrouping:  poolGrouping,
+		GoneRetention: goneRetention,
+	}
+	if player != nil {
+		monitorUseCase.AddObserver(player)
+		opts.Replay = player
+	}
 
 	// Create presentation layer (TUI) with use case
-	model := presentation.NewModel(monitorUseCase, owner, repoName, orgName, interval)
+	model := presentation.NewModel(monitorUseCase, owner, repoName, orgName, interval, opts)
 	p := tea.NewProgram(model, tea.WithAltScreen())
 
-	if _, err := p.Run(); err != nil {
+	_, err = p.Run()
+	if alerts != nil {
+		// Give the notifications sent on the last refresh a chance to arrive before exiting
+		if closeErr := alerts.Close(alertFlushTimeout); closeErr != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Warning: %v\n", closeErr)
+		}
+	}
+	if err != nil {
 		return fmt.Errorf("error running TUI: %w", err)
 	}
 
 	return nil
 }
+
+// newNotifiers creates the notifiers described in the configuration
+func newNotifiers(cfg *config.Config) ([]repository.Notifier, error) {
+	configs, err := cfg.AlertNotifiers()
+	if err != nil {
+		return nil, err
+	}
+	notifiers := make([]repository.Notifier, 0, len(configs))
+	for _, c := range configs {
+		switch c.Type {
+		case config.NotifierTypeWebhook:
+			notifiers = append(notifiers, notifier.NewWebhookNotifier(c.URL, c.Headers))
+		case config.NotifierTypeSlack:
+			notifiers = append(notifiers, notifier.NewSlackNotifier(c.URL))
+		case config.NotifierTypeCommand:
+			notifiers = append(notifiers, notifier.NewCommandNotifier(c.Command))
+		}
+	}
+	return notifiers, nil
+}
+
+// newNotificationOptions validates the notification flags
+func newNotificationOptions() (presentation.NotificationOptions, error) {
+	switch oscNotify {
+	case presentation.OSCNone, presentation.OSC9, presentation.OSC777:
+	default:
+		return presentation.NotificationOptions{}, fmt.Errorf("invalid --osc-notify value %q (use 9 or 777)", oscNotify)
+	}
+
+	events := make([]value_object.EventType, 0, len(notifyOn))
+	for _, e := range notifyOn {
+		eventType := value_object.EventType(e)
+		if !slices.Contains(value_object.AllEventTypes, eventType) {
+			return presentation.NotificationOptions{}, fmt.Errorf("invalid --notify-on value %q", e)
+		}
+		events = append(events, eventType)
+	}
+
+	return presentation.NotificationOptions{
+		Bell:   bell,
+		OSC:    oscNotify,
+		Events: events,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/cli/go-gh/v2/pkg/auth"
+	ghrepo "github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/spf13/pflag"
+)
+
+// Scope flags shared by the subcommands that act on a repository, organization or enterprise
+var (
+	scopeOrg        string
+	scopeRepo       string
+	scopeEnterprise string
+	scopeDebugPath  string
+)
+
+// addScopeFlags registers the scope flags on the flag set
+func addScopeFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&scopeOrg, "org", "", "Use an organization")
+	flags.StringVar(&scopeRepo, "repo", "", "Use a specific repository (owner/repo)")
+	flags.StringVar(&scopeEnterprise, "enterprise", "", "Use an enterprise")
+	flags.StringVar(&scopeDebugPath, "debug", "", "Debug mode: path to JSON file with mock runner data")
+}
+
+// newRunnerScope builds the scope from the flags, defaulting to the current repository
+func newRunnerScope() (value_object.RunnerScope, error) {
+	scope := value_object.RunnerScope{
+		Org:        scopeOrg,
+		Enterprise: scopeEnterprise,
+	}
+
+	if scopeRepo != "" {
+		parts := strings.Split(scopeRepo, "/")
+		if len(parts) != 2 {
+			return scope, fmt.Errorf("invalid repository format. Use owner/repo")
+		}
+		scope.Owner = parts[0]
+		scope.Repo = parts[1]
+	} else if scopeOrg == "" && scopeEnterprise == "" {
+		if scopeDebugPath != "" {
+			scope.Owner = "owner"
+			scope.Repo = "repo"
+		} else {
+			currentRepo, err := ghrepo.Current()
+			if err != nil {
+				return scope, fmt.Errorf("not in a git repository and no --repo, --org or --enterprise flag specified")
+			}
+			scope.Owner = currentRepo.Owner
+			scope.Repo = currentRepo.Name
+		}
+	}
+
+	return scope, scope.Validate()
+}
+
+// defaultServerURL is the web URL of github.com, used in debug mode and when gh has no authenticated host
+const defaultServerURL = "https://github.com"
+
+// serverURL returns the web URL of the GitHub instance in use
+// auth.DefaultHost reports where the host came from rather than an error; without an authenticated host
+// (source "default") the REST client talks to github.com, so the URL falls back to it as well
+func serverURL() string {
+	if scopeDebugPath != "" {
+		return defaultServerURL
+	}
+	host, source := auth.DefaultHost()
+	if source == "default" || host == "" {
+		return defaultServerURL
+	}
+	return "https://" + host
+}
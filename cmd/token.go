@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/debug"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/github"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tokenJSON bool
+
+	configCommand bool
+	runnerName    string
+	runnerLabels  []string
+	runnerGroup   string
+)
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Create runner registration and remove tokens",
+}
+
+var tokenRegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Create a token for registering a self-hosted runner",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runToken(cmd, value_object.TokenRegistration)
+	},
+}
+
+var tokenRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Create a token for removing a self-hosted runner",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runToken(cmd, value_object.TokenRemove)
+	},
+}
+
+// tokenOutput is the JSON representation of a token
+type tokenOutput struct {
+	Kind          value_object.TokenKind `json:"kind"`
+	Token         string                 `json:"token"`
+	ExpiresAt     time.Time              `json:"expires_at"`
+	ConfigCommand string                 `json:"config_command,omitempty"`
+}
+
+func init() {
+	addScopeFlags(tokenCmd.PersistentFlags())
+	tokenCmd.PersistentFlags().BoolVar(&tokenJSON, "json", false, "Print the token as JSON")
+	tokenCmd.PersistentFlags().BoolVar(&configCommand, "config-command", false, "Also print a ready-to-run config.sh command line")
+	tokenRegisterCmd.Flags().StringVar(&runnerName, "name", "", "Runner name used in the config.sh command line")
+	tokenRegisterCmd.Flags().StringSliceVar(&runnerLabels, "labels", nil, "Custom runner labels used in the config.sh command line")
+	tokenRegisterCmd.Flags().StringVar(&runnerGroup, "runner-group", "", "Runner group used in the config.sh command line (organization and enterprise only)")
+
+	tokenCmd.AddCommand(tokenRegisterCmd, tokenRemoveCmd)
+	rootCmd.AddCommand(tokenCmd)
+}
+
+func runToken(cmd *cobra.Command, kind value_object.TokenKind) error {
+	scope, err := newRunnerScope()
+	if err != nil {
+		return err
+	}
+
+	var tokenRepository repository.TokenRepository
+	if scopeDebugPath != "" {
+		data, err := debug.LoadDebugData(scopeDebugPath)
+		if err != nil {
+			return fmt.Errorf("failed to load debug data: %w", err)
+		}
+		tokenRepository = debug.NewTokenRepository(data)
+	} else {
+		tokenRepository, err = github.NewTokenRepository()
+		if err != nil {
+			return fmt.Errorf("failed to create GitHub client: %w", err)
+		}
+	}
+
+	token, err := usecase.NewTokenIssuer(tokenRepository).Issue(cmd.Context(), kind, scope)
+	if err != nil {
+		return err
+	}
+
+	output := tokenOutput{
+		Kind:      token.Kind,
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt,
+	}
+	if configCommand {
+		output.ConfigCommand, err = usecase.ConfigCommand(serverURL(), scope, token, usecase.ConfigCommandOptions{
+			Name:        runnerName,
+			Labels:      runnerLabels,
+			RunnerGroup: runnerGroup,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if tokenJSON {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+	return printToken(cmd.OutOrStdout(), output)
+}
+
+// printToken writes the token in plain text
+func printToken(w io.Writer, output tokenOutput) error {
+	_, err := fmt.Fprintf(w, "Token:      %s\nExpires at: %s\n", output.Token, output.ExpiresAt.Local().Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	if output.ConfigCommand != "" {
+		_, err = fmt.Fprintf(w, "Command:    %s\n", output.ConfigCommand)
+	}
+	return err
+}
@@ -11,9 +11,43 @@ type Job struct {
 	RunnerID     *int64
 	RunnerName   *string
 	StartedAt    *time.Time
+	CreatedAt    *time.Time
+	Labels       []string
 	WorkflowName string
 	Repository   string
 	HtmlUrl      string
+	Steps        []*JobStep
+}
+
+// JobStep represents a step of a workflow job
+type JobStep struct {
+	Number      int
+	Name        string
+	Status      string
+	Conclusion  string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// IsRunning returns true if the step is currently running
+func (s *JobStep) IsRunning() bool {
+	return s.Status == "in_progress"
+}
+
+// IsCompleted returns true if the step has finished
+func (s *JobStep) IsCompleted() bool {
+	return s.Status == "completed"
+}
+
+// GetDurationAt returns how long the step ran, up to the specified time while it is still running
+func (s *JobStep) GetDurationAt(currentTime time.Time) time.Duration {
+	if s.StartedAt == nil {
+		return 0
+	}
+	if s.CompletedAt != nil {
+		return s.CompletedAt.Sub(*s.StartedAt)
+	}
+	return currentTime.Sub(*s.StartedAt)
 }
 
 // IsRunning returns true if the job is currently running
@@ -21,9 +55,15 @@ func (j *Job) IsRunning() bool {
 	return j.Status == "in_progress"
 }
 
-// IsAssignedToRunner returns true if the job is assigned to a specific runner
-func (j *Job) IsAssignedToRunner(runnerID int64) bool {
-	return j.RunnerID != nil && *j.RunnerID == runnerID
+// HasRunnerID returns true if the job carries a usable runner ID
+// The API reports a null or zero ID for some ephemeral and scale set runners
+func (j *Job) HasRunnerID() bool {
+	return j.RunnerID != nil && *j.RunnerID != 0
+}
+
+// HasRunnerName returns true if the job carries a runner name
+func (j *Job) HasRunnerName() bool {
+	return j.RunnerName != nil && *j.RunnerName != ""
 }
 
 // GetExecutionDurationAt returns the duration from the start time to the specified time
@@ -33,3 +73,35 @@ func (j *Job) GetExecutionDurationAt(currentTime time.Time) time.Duration {
 	}
 	return currentTime.Sub(*j.StartedAt)
 }
+
+// CurrentStep returns the step the job is at and its position counted from 1
+// It is the running step, or the first step that has not completed yet; nil when the job reported no steps
+func (j *Job) CurrentStep() (int, *JobStep) {
+	for i, step := range j.Steps {
+		if step.IsRunning() {
+			return i + 1, step
+		}
+	}
+	for i, step := range j.Steps {
+		if !step.IsCompleted() {
+			return i + 1, step
+		}
+	}
+	if len(j.Steps) == 0 {
+		return 0, nil
+	}
+	return len(j.Steps), j.Steps[len(j.Steps)-1]
+}
+
+// IsQueued returns true if the job is waiting for a runner
+func (j *Job) IsQueued() bool {
+	return j.Status == "queued"
+}
+
+// GetQueuedDurationAt returns the duration the job has been waiting since it was created
+func (j *Job) GetQueuedDurationAt(currentTime time.Time) time.Duration {
+	if j.CreatedAt == nil {
+		return 0
+	}
+	return currentTime.Sub(*j.CreatedAt)
+}
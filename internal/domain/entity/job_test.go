@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"testing"
 	"time"
 )
@@ -72,21 +73,80 @@ func TestJobMethods(t *testing.T) {
 		}
 	})
 
-	t.Run("IsAssignedToRunner", func(t *testing.T) {
-		runnerID := int64(123)
-		job := &Job{RunnerID: &runnerID}
+	t.Run("IsQueued", func(t *testing.T) {
+		job := &Job{Status: "queued"}
+		if !job.IsQueued() {
+			t.Error("expected IsQueued() to be true for queued status")
+		}
+
+		job.Status = "in_progress"
+		if job.IsQueued() {
+			t.Error("expected IsQueued() to be false for in_progress status")
+		}
+	})
 
-		if !job.IsAssignedToRunner(123) {
-			t.Error("expected IsAssignedToRunner(123) to be true")
+	t.Run("GetQueuedDurationAt", func(t *testing.T) {
+		createdAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+		job := &Job{CreatedAt: &createdAt}
+
+		if d := job.GetQueuedDurationAt(createdAt.Add(5 * time.Minute)); d != 5*time.Minute {
+			t.Errorf("expected 5m, got %s", d)
+		}
+
+		job.CreatedAt = nil
+		if d := job.GetQueuedDurationAt(createdAt); d != 0 {
+			t.Errorf("expected 0 when CreatedAt is nil, got %s", d)
 		}
+	})
 
-		if job.IsAssignedToRunner(456) {
-			t.Error("expected IsAssignedToRunner(456) to be false")
+	t.Run("CurrentStep", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			statuses         []string
+			expectedPosition int
+			expectedStep     string
+		}{
+			{name: "no steps", statuses: nil, expectedPosition: 0},
+			{name: "running step", statuses: []string{"completed", "in_progress", "queued"}, expectedPosition: 2, expectedStep: "step-2"},
+			{name: "between steps", statuses: []string{"completed", "completed", "queued"}, expectedPosition: 3, expectedStep: "step-3"},
+			{name: "every step completed", statuses: []string{"completed", "completed"}, expectedPosition: 2, expectedStep: "step-2"},
 		}
 
-		job.RunnerID = nil
-		if job.IsAssignedToRunner(123) {
-			t.Error("expected IsAssignedToRunner(123) to be false when RunnerID is nil")
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				job := &Job{}
+				for i, status := range tt.statuses {
+					job.Steps = append(job.Steps, &JobStep{Number: i + 1, Name: fmt.Sprintf("step-%d", i+1), Status: status})
+				}
+
+				position, step := job.CurrentStep()
+				if position != tt.expectedPosition {
+					t.Errorf("expected position %d, got %d", tt.expectedPosition, position)
+				}
+				if tt.expectedStep == "" {
+					if step != nil {
+						t.Errorf("expected no step, got %s", step.Name)
+					}
+				} else if step == nil || step.Name != tt.expectedStep {
+					t.Errorf("expected %s, got %v", tt.expectedStep, step)
+				}
+			})
+		}
+	})
+
+	t.Run("JobStep GetDurationAt", func(t *testing.T) {
+		startedAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+		completedAt := startedAt.Add(time.Minute)
+		now := startedAt.Add(5 * time.Minute)
+
+		if d := (&JobStep{StartedAt: &startedAt, CompletedAt: &completedAt}).GetDurationAt(now); d != time.Minute {
+			t.Errorf("expected 1m for a completed step, got %s", d)
+		}
+		if d := (&JobStep{StartedAt: &startedAt}).GetDurationAt(now); d != 5*time.Minute {
+			t.Errorf("expected 5m for a running step, got %s", d)
+		}
+		if d := (&JobStep{}).GetDurationAt(now); d != 0 {
+			t.Errorf("expected 0 for a pending step, got %s", d)
 		}
 	})
 }
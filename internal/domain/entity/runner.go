@@ -6,8 +6,11 @@ import "time"
 type RunnerStatus string
 
 const (
-	StatusIdle    RunnerStatus = "Idle"
-	StatusActive  RunnerStatus = "Active"
+	StatusIdle RunnerStatus = "Idle"
+	// StatusActive is a runner executing a job that is visible in the job data
+	StatusActive RunnerStatus = "Active"
+	// StatusBusy is a runner reported busy whose job is not visible, e.g. a job in a repository we cannot see
+	StatusBusy    RunnerStatus = "Busy"
 	StatusOffline RunnerStatus = "Offline"
 )
 
@@ -21,12 +24,17 @@ type Runner struct {
 	UpdatedAt time.Time
 }
 
-// IsOnline returns true if the runner is online (idle or active)
+// IsOnline returns true if the runner is online (idle, active or busy)
 func (r *Runner) IsOnline() bool {
-	return r.Status == StatusIdle || r.Status == StatusActive
+	return r.Status == StatusIdle || r.Status == StatusActive || r.Status == StatusBusy
 }
 
 // IsActive returns true if the runner is active
 func (r *Runner) IsActive() bool {
 	return r.Status == StatusActive
 }
+
+// IsBusy returns true if the runner is executing a job, whether or not the job is visible
+func (r *Runner) IsBusy() bool {
+	return r.Status == StatusActive || r.Status == StatusBusy
+}
@@ -21,6 +21,11 @@ func TestRunnerStatus(t *testing.T) {
 			status: StatusActive,
 			valid:  true,
 		},
+		{
+			name:   "busy status is valid",
+			status: StatusBusy,
+			valid:  true,
+		},
 		{
 			name:   "offline status is valid",
 			status: StatusOffline,
@@ -75,6 +80,7 @@ func TestRunnerMethods(t *testing.T) {
 		isOnline bool
 		isIdle   bool
 		isActive bool
+		isBusy   bool
 	}{
 		{
 			name:     "idle runner",
@@ -82,6 +88,7 @@ func TestRunnerMethods(t *testing.T) {
 			isOnline: true,
 			isIdle:   true,
 			isActive: false,
+			isBusy:   false,
 		},
 		{
 			name:     "active runner",
@@ -89,6 +96,15 @@ func TestRunnerMethods(t *testing.T) {
 			isOnline: true,
 			isIdle:   false,
 			isActive: true,
+			isBusy:   true,
+		},
+		{
+			name:     "busy runner",
+			status:   StatusBusy,
+			isOnline: true,
+			isIdle:   false,
+			isActive: false,
+			isBusy:   true,
 		},
 		{
 			name:     "offline runner",
@@ -96,6 +112,7 @@ func TestRunnerMethods(t *testing.T) {
 			isOnline: false,
 			isIdle:   false,
 			isActive: false,
+			isBusy:   false,
 		},
 	}
 
@@ -110,6 +127,10 @@ func TestRunnerMethods(t *testing.T) {
 			if runner.IsActive() != tt.isActive {
 				t.Errorf("IsActive() = %v, want %v", runner.IsActive(), tt.isActive)
 			}
+
+			if runner.IsBusy() != tt.isBusy {
+				t.Errorf("IsBusy() = %v, want %v", runner.IsBusy(), tt.isBusy)
+			}
 		})
 	}
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// DownloadRepository defines the interface for accessing runner application downloads
+type DownloadRepository interface {
+	// FetchRunnerDownloads returns the runner application packages available to the scope
+	FetchRunnerDownloads(ctx context.Context, scope value_object.RunnerScope) ([]*value_object.RunnerDownload, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
 )
@@ -9,5 +10,30 @@ import (
 // JobRepository defines the interface for accessing job data
 type JobRepository interface {
 	// FetchActiveJobs retrieves all active jobs for a repository or organization
+	// Jobs that could not all be fetched are returned with an *IncompleteJobsError
 	FetchActiveJobs(ctx context.Context, owner, repo, org string) ([]*entity.Job, error)
+	// CancelWorkflowRun requests cancellation of a workflow run
+	CancelWorkflowRun(ctx context.Context, owner, repo string, runID int64) error
+	// ForceCancelWorkflowRun cancels a workflow run, bypassing conditions such as always()
+	ForceCancelWorkflowRun(ctx context.Context, owner, repo string, runID int64) error
+	// RerunFailedJobs re-runs the failed jobs of a workflow run
+	RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error
+	// RerunJob re-runs a single job
+	RerunJob(ctx context.Context, owner, repo string, jobID int64) error
+	// FetchJobLog downloads the log of a job as plain text
+	FetchJobLog(ctx context.Context, owner, repo string, jobID int64) (string, error)
+}
+
+// IncompleteJobsError is returned along with the jobs that could be fetched when the jobs of some workflow runs
+// could not be, so jobs missing from the result may still be active
+type IncompleteJobsError struct {
+	Errs []error
+}
+
+func (e *IncompleteJobsError) Error() string {
+	return errors.Join(e.Errs...).Error()
+}
+
+func (e *IncompleteJobsError) Unwrap() []error {
+	return e.Errs
 }
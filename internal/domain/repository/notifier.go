@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// Notifier defines the interface for delivering alert events
+type Notifier interface {
+	// Notify sends an alert event to its destination
+	Notify(ctx context.Context, event *value_object.AlertEvent) error
+}
@@ -10,4 +10,12 @@ import (
 type RunnerRepository interface {
 	// FetchRunners all runners for a repository or organization
 	FetchRunners(ctx context.Context, owner, repo, org string) ([]*entity.Runner, error)
+	// DeleteRunner removes a runner from a repository or organization
+	DeleteRunner(ctx context.Context, owner, repo, org string, runnerID int64) error
+	// AddLabels adds custom labels to a runner
+	AddLabels(ctx context.Context, owner, repo, org string, runnerID int64, labels []string) error
+	// RemoveLabel removes a custom label from a runner
+	RemoveLabel(ctx context.Context, owner, repo, org string, runnerID int64, label string) error
+	// SetLabels replaces all custom labels of a runner
+	SetLabels(ctx context.Context, owner, repo, org string, runnerID int64, labels []string) error
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// TokenRepository defines the interface for minting runner registration and remove tokens
+type TokenRepository interface {
+	// CreateRegistrationToken creates a token for registering a runner in the scope
+	CreateRegistrationToken(ctx context.Context, scope value_object.RunnerScope) (*value_object.RunnerToken, error)
+	// CreateRemoveToken creates a token for removing a runner from the scope
+	CreateRemoveToken(ctx context.Context, scope value_object.RunnerScope) (*value_object.RunnerToken, error)
+}
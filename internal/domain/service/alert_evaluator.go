@@ -0,0 +1,167 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// AlertEvaluator evaluates alert rules against successive snapshots
+// It remembers since when each runner is offline; which state was already reported is left to the caller
+type AlertEvaluator struct {
+	rules        []value_object.AlertRule
+	offlineSince map[int64]time.Time
+}
+
+// NewAlertEvaluator creates a new AlertEvaluator for the given rules
+func NewAlertEvaluator(rules []value_object.AlertRule) *AlertEvaluator {
+	return &AlertEvaluator{
+		rules:        rules,
+		offlineSince: make(map[int64]time.Time),
+	}
+}
+
+// Evaluate checks every rule against the snapshot and returns the current state of each rule, in rule order
+func (e *AlertEvaluator) Evaluate(data *value_object.MonitorData) []*value_object.AlertEvent {
+	e.trackOfflineRunners(data)
+
+	events := make([]*value_object.AlertEvent, 0, len(e.rules))
+	for _, rule := range e.rules {
+		matched, subjects, message := e.evaluateRule(rule, data)
+		if matched {
+			events = append(events, &value_object.AlertEvent{
+				Rule:     rule,
+				State:    value_object.AlertFiring,
+				Message:  message,
+				Subjects: subjects,
+				At:       data.CurrentTime,
+			})
+			continue
+		}
+		events = append(events, &value_object.AlertEvent{
+			Rule:    rule,
+			State:   value_object.AlertResolved,
+			Message: fmt.Sprintf("%s resolved", rule.Name),
+			At:      data.CurrentTime,
+		})
+	}
+	return events
+}
+
+// trackOfflineRunners records when each runner was first seen offline
+func (e *AlertEvaluator) trackOfflineRunners(data *value_object.MonitorData) {
+	seen := make(map[int64]bool, len(data.Runners))
+	for _, runner := range data.Runners {
+		seen[runner.ID] = true
+		if runner.IsOnline() {
+			delete(e.offlineSince, runner.ID)
+			continue
+		}
+		if _, ok := e.offlineSince[runner.ID]; !ok {
+			e.offlineSince[runner.ID] = data.CurrentTime
+		}
+	}
+
+	for id := range e.offlineSince {
+		if !seen[id] {
+			delete(e.offlineSince, id)
+		}
+	}
+}
+
+// evaluateRule reports whether the rule matches, which runners or jobs triggered it, and a message
+func (e *AlertEvaluator) evaluateRule(rule value_object.AlertRule, data *value_object.MonitorData) (bool, []string, string) {
+	switch rule.Condition {
+	case value_object.ConditionRunnerCount:
+		var subjects []string
+		for _, runner := range data.Runners {
+			if matchesRunnerLabel(runner, rule.Label) && matchesRunnerStatus(runner, rule.Status) {
+				subjects = append(subjects, runner.Name)
+			}
+		}
+		count := len(subjects)
+		message := fmt.Sprintf("%s: %d runner(s) matched (expected %s %d)", rule.Name, count, rule.Operator, rule.Value)
+		return compareCount(count, rule.Operator, rule.Value), subjects, message
+
+	case value_object.ConditionJobDuration:
+		var subjects []string
+		for _, job := range data.Jobs {
+			if job.IsRunning() && matchesJob(job, rule) && job.GetExecutionDurationAt(data.CurrentTime) > rule.Duration {
+				subjects = append(subjects, job.Name)
+			}
+		}
+		message := fmt.Sprintf("%s: %d job(s) running longer than %s", rule.Name, len(subjects), rule.Duration)
+		return len(subjects) > 0, subjects, message
+
+	case value_object.ConditionQueueWait:
+		var subjects []string
+		for _, job := range data.Jobs {
+			if job.IsQueued() && matchesJob(job, rule) && job.GetQueuedDurationAt(data.CurrentTime) > rule.Duration {
+				subjects = append(subjects, job.Name)
+			}
+		}
+		message := fmt.Sprintf("%s: %d job(s) queued longer than %s", rule.Name, len(subjects), rule.Duration)
+		return len(subjects) > 0, subjects, message
+
+	case value_object.ConditionRunnerOffline:
+		var subjects []string
+		for _, runner := range data.Runners {
+			since, ok := e.offlineSince[runner.ID]
+			if ok && matchesRunnerLabel(runner, rule.Label) && data.CurrentTime.Sub(since) > rule.Duration {
+				subjects = append(subjects, runner.Name)
+			}
+		}
+		message := fmt.Sprintf("%s: %d runner(s) offline longer than %s", rule.Name, len(subjects), rule.Duration)
+		return len(subjects) > 0, subjects, message
+	}
+
+	return false, nil, ""
+}
+
+// matchesRunnerLabel returns true if the runner has the label or no label is required
+func matchesRunnerLabel(runner *entity.Runner, label string) bool {
+	return label == "" || slices.Contains(runner.Labels, label)
+}
+
+// matchesRunnerStatus returns true if the runner has the status or no status is required
+func matchesRunnerStatus(runner *entity.Runner, status string) bool {
+	switch status {
+	case "":
+		return true
+	case value_object.StatusOnline:
+		return runner.IsOnline()
+	default:
+		return string(runner.Status) == status
+	}
+}
+
+// matchesJob returns true if the job satisfies the label and workflow filters of the rule
+func matchesJob(job *entity.Job, rule value_object.AlertRule) bool {
+	if rule.Label != "" && !slices.Contains(job.Labels, rule.Label) {
+		return false
+	}
+	return rule.Workflow == "" || job.WorkflowName == rule.Workflow
+}
+
+// compareCount compares a count with a value using the given operator
+func compareCount(count int, operator string, value int) bool {
+	switch operator {
+	case "<":
+		return count < value
+	case "<=":
+		return count <= value
+	case "==":
+		return count == value
+	case "!=":
+		return count != value
+	case ">=":
+		return count >= value
+	case ">":
+		return count > value
+	default:
+		return false
+	}
+}
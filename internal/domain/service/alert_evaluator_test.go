@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestAlertEvaluator(t *testing.T) {
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	t.Run("fires and resolves when all gpu runners go offline and come back", func(t *testing.T) {
+		evaluator := NewAlertEvaluator([]value_object.AlertRule{
+			{Name: "gpu-down", Condition: value_object.ConditionRunnerCount, Label: "gpu", Status: value_object.StatusOnline, Operator: "==", Value: 0},
+		})
+
+		offline := &value_object.MonitorData{
+			CurrentTime: now,
+			Runners: []*entity.Runner{
+				{ID: 1, Name: "gpu-1", Status: entity.StatusOffline, Labels: []string{"gpu"}},
+				{ID: 2, Name: "cpu-1", Status: entity.StatusIdle, Labels: []string{"linux"}},
+			},
+		}
+
+		events := evaluator.Evaluate(offline)
+		if len(events) != 1 || events[0].State != value_object.AlertFiring {
+			t.Fatalf("expected the rule to fire, got %v", events)
+		}
+
+		online := &value_object.MonitorData{
+			CurrentTime: now.Add(time.Minute),
+			Runners: []*entity.Runner{
+				{ID: 1, Name: "gpu-1", Status: entity.StatusIdle, Labels: []string{"gpu"}},
+			},
+		}
+		events = evaluator.Evaluate(online)
+		if len(events) != 1 || events[0].State != value_object.AlertResolved || events[0].Message != "gpu-down resolved" {
+			t.Fatalf("expected the rule to be resolved, got %v", events)
+		}
+	})
+
+	t.Run("queue wait", func(t *testing.T) {
+		evaluator := NewAlertEvaluator([]value_object.AlertRule{
+			{Name: "queue", Condition: value_object.ConditionQueueWait, Duration: 10 * time.Minute},
+		})
+
+		createdAt := now.Add(-11 * time.Minute)
+		events := evaluator.Evaluate(&value_object.MonitorData{
+			CurrentTime: now,
+			Jobs: []*entity.Job{
+				{ID: 1, Name: "build", Status: "queued", CreatedAt: &createdAt},
+			},
+		})
+
+		if len(events) != 1 || events[0].State != value_object.AlertFiring {
+			t.Fatalf("expected the rule to fire, got %v", events)
+		}
+		if len(events[0].Subjects) != 1 || events[0].Subjects[0] != "build" {
+			t.Errorf("expected subject 'build', got %v", events[0].Subjects)
+		}
+	})
+
+	t.Run("job duration filtered by workflow", func(t *testing.T) {
+		evaluator := NewAlertEvaluator([]value_object.AlertRule{
+			{Name: "slow-ci", Condition: value_object.ConditionJobDuration, Workflow: "CI", Duration: time.Hour},
+		})
+
+		startedAt := now.Add(-2 * time.Hour)
+		events := evaluator.Evaluate(&value_object.MonitorData{
+			CurrentTime: now,
+			Jobs: []*entity.Job{
+				{ID: 1, Name: "nightly", Status: "in_progress", StartedAt: &startedAt, WorkflowName: "Nightly"},
+			},
+		})
+
+		if len(events) != 1 || events[0].State != value_object.AlertResolved {
+			t.Errorf("expected the rule not to fire for other workflows, got %v", events)
+		}
+	})
+
+	t.Run("runner offline for longer than duration", func(t *testing.T) {
+		evaluator := NewAlertEvaluator([]value_object.AlertRule{
+			{Name: "offline", Condition: value_object.ConditionRunnerOffline, Duration: 5 * time.Minute},
+		})
+
+		runners := []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusOffline}}
+
+		if events := evaluator.Evaluate(&value_object.MonitorData{CurrentTime: now, Runners: runners}); events[0].State != value_object.AlertResolved {
+			t.Fatalf("expected the rule not to fire when the runner just went offline, got %v", events)
+		}
+
+		events := evaluator.Evaluate(&value_object.MonitorData{CurrentTime: now.Add(6 * time.Minute), Runners: runners})
+		if len(events) != 1 || events[0].State != value_object.AlertFiring {
+			t.Fatalf("expected the rule to fire, got %v", events)
+		}
+	})
+}
+
+func TestCompareCount(t *testing.T) {
+	tests := []struct {
+		operator string
+		count    int
+		value    int
+		expected bool
+	}{
+		{"<", 1, 2, true},
+		{"<=", 2, 2, true},
+		{"==", 0, 0, true},
+		{"!=", 0, 0, false},
+		{">=", 1, 2, false},
+		{">", 3, 2, true},
+		{"~", 3, 2, false},
+	}
+
+	for _, tt := range tests {
+		if result := compareCount(tt.count, tt.operator, tt.value); result != tt.expected {
+			t.Errorf("compareCount(%d %s %d) = %v, want %v", tt.count, tt.operator, tt.value, result, tt.expected)
+		}
+	}
+}
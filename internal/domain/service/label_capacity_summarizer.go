@@ -0,0 +1,66 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// SummarizeLabelCapacity aggregates the runners and queued jobs by label, sorted by pressure
+// Labels requested by queued jobs but carried by no runner are included with a total of zero
+func SummarizeLabelCapacity(runners []*entity.Runner, jobs []*entity.Job, currentTime time.Time) []*value_object.LabelCapacity {
+	capacities := make(map[string]*value_object.LabelCapacity)
+	capacityFor := func(label string) *value_object.LabelCapacity {
+		capacity, ok := capacities[label]
+		if !ok {
+			capacity = &value_object.LabelCapacity{Label: label}
+			capacities[label] = capacity
+		}
+		return capacity
+	}
+
+	for _, runner := range runners {
+		for _, label := range runner.Labels {
+			capacity := capacityFor(label)
+			capacity.Total++
+			switch {
+			case !runner.IsOnline():
+				capacity.Offline++
+			case runner.IsBusy():
+				capacity.Active++
+			default:
+				capacity.Idle++
+			}
+		}
+	}
+
+	for _, job := range jobs {
+		if !job.IsQueued() {
+			continue
+		}
+		wait := job.GetQueuedDurationAt(currentTime)
+		for _, label := range job.Labels {
+			capacity := capacityFor(label)
+			capacity.QueuedJobs++
+			capacity.LongestQueueWait = max(capacity.LongestQueueWait, wait)
+		}
+	}
+
+	result := make([]*value_object.LabelCapacity, 0, len(capacities))
+	for _, capacity := range capacities {
+		result = append(result, capacity)
+	}
+	slices.SortFunc(result, func(a, b *value_object.LabelCapacity) int {
+		if c := cmp.Compare(b.Pressure(), a.Pressure()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.LongestQueueWait, a.LongestQueueWait); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Label, b.Label)
+	})
+	return result
+}
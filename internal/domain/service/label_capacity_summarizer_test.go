@@ -0,0 +1,52 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestSummarizeLabelCapacity(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	queuedSince := func(d time.Duration) *time.Time {
+		createdAt := now.Add(-d)
+		return &createdAt
+	}
+
+	runners := []*entity.Runner{
+		{ID: 1, Status: entity.StatusIdle, Labels: []string{"linux-x64"}},
+		{ID: 2, Status: entity.StatusActive, Labels: []string{"linux-x64"}},
+		{ID: 3, Status: entity.StatusOffline, Labels: []string{"linux-x64"}},
+		{ID: 4, Status: entity.StatusBusy, Labels: []string{"gpu"}},
+	}
+	jobs := []*entity.Job{
+		{ID: 100, Status: "queued", Labels: []string{"gpu"}, CreatedAt: queuedSince(5 * time.Minute)},
+		{ID: 101, Status: "queued", Labels: []string{"gpu"}, CreatedAt: queuedSince(2 * time.Minute)},
+		{ID: 102, Status: "queued", Labels: []string{"macos-arm"}, CreatedAt: queuedSince(time.Minute)},
+		{ID: 103, Status: "in_progress", Labels: []string{"linux-x64"}},
+	}
+
+	capacities := SummarizeLabelCapacity(runners, jobs, now)
+
+	expected := []value_object.LabelCapacity{
+		{Label: "gpu", Total: 1, Active: 1, QueuedJobs: 2, LongestQueueWait: 5 * time.Minute},
+		{Label: "macos-arm", QueuedJobs: 1, LongestQueueWait: time.Minute},
+		{Label: "linux-x64", Total: 3, Idle: 1, Active: 1, Offline: 1},
+	}
+	if len(capacities) != len(expected) {
+		t.Fatalf("expected %d labels, got %d", len(expected), len(capacities))
+	}
+	for i := range expected {
+		if *capacities[i] != expected[i] {
+			t.Errorf("expected %+v at %d, got %+v", expected[i], i, *capacities[i])
+		}
+	}
+	if pressure := capacities[0].Pressure(); pressure != 2 {
+		t.Errorf("expected gpu pressure 2, got %d", pressure)
+	}
+	if pressure := capacities[2].Pressure(); pressure != -1 {
+		t.Errorf("expected linux-x64 pressure -1, got %d", pressure)
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+const (
+	// forecastWindow is how long completed jobs are used to estimate the throughput
+	forecastWindow = time.Hour
+	// Number of completed jobs needed for a medium or high confidence estimate
+	mediumConfidenceSamples = 3
+	highConfidenceSamples   = 10
+)
+
+// jobCompletion is a job that finished while it was observed
+type jobCompletion struct {
+	at       time.Time
+	duration time.Duration
+}
+
+// QueueForecaster estimates queue waits from the jobs completed in successive snapshots
+// Jobs are grouped by their label set, since only runners carrying all of the labels can pick them up
+type QueueForecaster struct {
+	running     map[int64]*entity.Job
+	completions map[string][]jobCompletion
+	// since is the time of the first snapshot, completions are only observed from then on
+	since time.Time
+}
+
+// NewQueueForecaster creates a new QueueForecaster without history
+func NewQueueForecaster() *QueueForecaster {
+	return &QueueForecaster{
+		running:     make(map[int64]*entity.Job),
+		completions: make(map[string][]jobCompletion),
+	}
+}
+
+// Forecast records the jobs that completed since the previous snapshot and estimates the wait of every queued job
+// Queued jobs are served first come, first served: a job waits for the jobs ahead of it to start, at the rate
+// jobs with the same labels completed recently, unless enough matching runners are idle
+func (f *QueueForecaster) Forecast(data *value_object.MonitorData) []*value_object.QueueForecast {
+	if f.since.IsZero() {
+		f.since = data.CurrentTime
+	}
+	f.recordCompletions(data)
+
+	var queued []*entity.Job
+	for _, job := range data.Jobs {
+		if job.IsQueued() {
+			queued = append(queued, job)
+		}
+	}
+	slices.SortStableFunc(queued, func(a, b *entity.Job) int {
+		return cmp.Compare(b.GetQueuedDurationAt(data.CurrentTime), a.GetQueuedDurationAt(data.CurrentTime))
+	})
+	started := assignIdleRunners(data.Runners, queued)
+
+	groups := make(map[string][]*entity.Job)
+	for _, job := range queued {
+		key := labelSetKey(job.Labels)
+		groups[key] = append(groups[key], job)
+	}
+
+	var forecasts []*value_object.QueueForecast
+	for key, jobs := range groups {
+		idle := started[key]
+		samples := f.completions[key]
+		for position, job := range jobs {
+			forecast := &value_object.QueueForecast{
+				Job:        job,
+				Position:   position,
+				Confidence: confidenceFor(len(samples)),
+				Samples:    len(samples),
+			}
+			if waiting := position - idle + 1; waiting > 0 && len(samples) > 0 {
+				forecast.EstimatedWait = time.Duration(waiting) * f.completionInterval(samples, data.CurrentTime)
+			}
+			forecasts = append(forecasts, forecast)
+		}
+	}
+
+	slices.SortFunc(forecasts, func(a, b *value_object.QueueForecast) int {
+		if c := cmp.Compare(b.Job.GetQueuedDurationAt(data.CurrentTime), a.Job.GetQueuedDurationAt(data.CurrentTime)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Job.ID, b.Job.ID)
+	})
+	return forecasts
+}
+
+// recordCompletions remembers the running jobs that are no longer running and forgets completions outside the window
+// Jobs missing from a snapshot whose jobs could not all be fetched may still run, so they are kept until a
+// complete snapshot shows whether they finished
+func (f *QueueForecaster) recordCompletions(data *value_object.MonitorData) {
+	running := make(map[int64]*entity.Job, len(f.running))
+	for _, job := range data.Jobs {
+		if job.IsRunning() {
+			running[job.ID] = job
+		}
+	}
+
+	for id, job := range f.running {
+		if _, ok := running[id]; ok {
+			continue
+		}
+		if data.JobsIncomplete {
+			running[id] = job
+			continue
+		}
+		key := labelSetKey(job.Labels)
+		f.completions[key] = append(f.completions[key], jobCompletion{
+			at:       data.CurrentTime,
+			duration: job.GetExecutionDurationAt(data.CurrentTime),
+		})
+	}
+	f.running = running
+
+	for key, completions := range f.completions {
+		completions = slices.DeleteFunc(completions, func(c jobCompletion) bool {
+			return data.CurrentTime.Sub(c.at) > forecastWindow
+		})
+		if len(completions) == 0 {
+			delete(f.completions, key)
+			continue
+		}
+		f.completions[key] = completions
+	}
+}
+
+// completionInterval returns the average time between two completions within the observed part of the window
+// The observed span is at least the average job duration, so that a burst of completions right after startup
+// is not mistaken for the rate
+func (f *QueueForecaster) completionInterval(completions []jobCompletion, now time.Time) time.Duration {
+	var total time.Duration
+	for _, c := range completions {
+		total += c.duration
+	}
+	span := max(min(now.Sub(f.since), forecastWindow), total/time.Duration(len(completions)))
+	return span / time.Duration(len(completions))
+}
+
+// confidenceFor returns the confidence of an estimate based on the number of completions
+func confidenceFor(samples int) value_object.ForecastConfidence {
+	switch {
+	case samples >= highConfidenceSamples:
+		return value_object.ConfidenceHigh
+	case samples >= mediumConfidenceSamples:
+		return value_object.ConfidenceMedium
+	case samples > 0:
+		return value_object.ConfidenceLow
+	default:
+		return value_object.ConfidenceNone
+	}
+}
+
+// assignIdleRunners hands every idle runner to at most one queued job, the longest waiting job first, and returns
+// the number of jobs of each label set that start right away
+// Jobs of one label set are in queue order, so the jobs that get a runner are always the first ones of their set
+func assignIdleRunners(runners []*entity.Runner, queued []*entity.Job) map[string]int {
+	var idle []*entity.Runner
+	for _, runner := range runners {
+		if runner.Status == entity.StatusIdle {
+			idle = append(idle, runner)
+		}
+	}
+
+	started := make(map[string]int)
+	for _, job := range queued {
+		i := slices.IndexFunc(idle, func(runner *entity.Runner) bool { return hasAllLabels(runner.Labels, job.Labels) })
+		if i < 0 {
+			continue
+		}
+		idle = slices.Delete(idle, i, i+1)
+		started[labelSetKey(job.Labels)]++
+	}
+	return started
+}
+
+// labelSetKey identifies a set of labels regardless of their order
+func labelSetKey(labels []string) string {
+	sorted := slices.Clone(labels)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
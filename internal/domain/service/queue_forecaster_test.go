@@ -0,0 +1,135 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestQueueForecaster(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	labels := []string{"self-hosted", "gpu"}
+	busyRunner := &entity.Runner{ID: 1, Name: "gpu-01", Status: entity.StatusActive, Labels: labels}
+
+	at := func(d time.Duration) *time.Time {
+		t := start.Add(d)
+		return &t
+	}
+	running := func(id int64, startedAt time.Duration) *entity.Job {
+		return &entity.Job{ID: id, Status: "in_progress", Labels: labels, StartedAt: at(startedAt)}
+	}
+	queued := func(id int64, createdAt time.Duration) *entity.Job {
+		return &entity.Job{ID: id, Status: "queued", Labels: []string{"gpu", "self-hosted"}, CreatedAt: at(createdAt)}
+	}
+	snapshot := func(now time.Duration, runners []*entity.Runner, jobs ...*entity.Job) *value_object.MonitorData {
+		return &value_object.MonitorData{CurrentTime: start.Add(now), Runners: runners, Jobs: jobs}
+	}
+
+	t.Run("no history", func(t *testing.T) {
+		forecaster := NewQueueForecaster()
+
+		forecasts := forecaster.Forecast(snapshot(0, []*entity.Runner{busyRunner}, queued(100, 0)))
+
+		if len(forecasts) != 1 {
+			t.Fatalf("expected 1 forecast, got %d", len(forecasts))
+		}
+		if forecasts[0].Confidence != value_object.ConfidenceNone || forecasts[0].EstimatedWait != 0 {
+			t.Errorf("expected no estimate, got %s with %s", forecasts[0].EstimatedWait, forecasts[0].Confidence)
+		}
+	})
+
+	t.Run("estimates from the completion rate in queue order", func(t *testing.T) {
+		forecaster := NewQueueForecaster()
+		runners := []*entity.Runner{busyRunner}
+
+		// Jobs of 10 minutes complete every 10 minutes
+		forecaster.Forecast(snapshot(0, runners, running(1, 0)))
+		forecaster.Forecast(snapshot(10*time.Minute, runners, running(2, 10*time.Minute)))
+		forecaster.Forecast(snapshot(20*time.Minute, runners, running(3, 20*time.Minute)))
+		forecasts := forecaster.Forecast(snapshot(30*time.Minute, runners,
+			running(4, 30*time.Minute), queued(101, 29*time.Minute), queued(100, 25*time.Minute)))
+
+		if len(forecasts) != 2 {
+			t.Fatalf("expected 2 forecasts, got %d", len(forecasts))
+		}
+		first, second := forecasts[0], forecasts[1]
+		if first.Job.ID != 100 || first.Position != 0 || second.Job.ID != 101 || second.Position != 1 {
+			t.Fatalf("expected jobs 100 and 101 in queue order, got %d at %d and %d at %d",
+				first.Job.ID, first.Position, second.Job.ID, second.Position)
+		}
+		if first.EstimatedWait != 10*time.Minute || second.EstimatedWait != 20*time.Minute {
+			t.Errorf("expected waits of 10m and 20m, got %s and %s", first.EstimatedWait, second.EstimatedWait)
+		}
+		if first.Confidence != value_object.ConfidenceMedium || first.Samples != 3 {
+			t.Errorf("expected medium confidence from 3 samples, got %s from %d", first.Confidence, first.Samples)
+		}
+	})
+
+	t.Run("an idle runner starts the job right away", func(t *testing.T) {
+		forecaster := NewQueueForecaster()
+		idleRunner := &entity.Runner{ID: 2, Name: "gpu-02", Status: entity.StatusIdle, Labels: labels}
+		runners := []*entity.Runner{busyRunner, idleRunner}
+
+		forecaster.Forecast(snapshot(0, runners, running(1, 0)))
+		forecasts := forecaster.Forecast(snapshot(10*time.Minute, runners, queued(100, 9*time.Minute), queued(101, 9*time.Minute)))
+
+		if forecasts[0].EstimatedWait != 0 {
+			t.Errorf("expected the first job to start right away, got %s", forecasts[0].EstimatedWait)
+		}
+		if forecasts[1].EstimatedWait != 10*time.Minute {
+			t.Errorf("expected the second job to wait 10m, got %s", forecasts[1].EstimatedWait)
+		}
+		if forecasts[0].Confidence != value_object.ConfidenceLow {
+			t.Errorf("expected low confidence, got %s", forecasts[0].Confidence)
+		}
+	})
+
+	t.Run("label sets share the idle runners", func(t *testing.T) {
+		forecaster := NewQueueForecaster()
+		idleRunner := &entity.Runner{ID: 2, Name: "gpu-02", Status: entity.StatusIdle, Labels: labels}
+		runners := []*entity.Runner{busyRunner, idleRunner}
+		gpuOnly := &entity.Job{ID: 102, Status: "queued", Labels: []string{"gpu"}, CreatedAt: at(8 * time.Minute)}
+
+		forecaster.Forecast(snapshot(0, runners, running(1, 0)))
+		forecasts := forecaster.Forecast(snapshot(10*time.Minute, runners, gpuOnly, queued(100, 9*time.Minute)))
+
+		if forecasts[0].Job.ID != 102 || forecasts[0].EstimatedWait != 0 {
+			t.Errorf("expected the longest waiting job to take the idle runner, got job %d waiting %s",
+				forecasts[0].Job.ID, forecasts[0].EstimatedWait)
+		}
+		if forecasts[1].Job.ID != 100 || forecasts[1].EstimatedWait != 10*time.Minute {
+			t.Errorf("expected job 100 to wait 10m for a runner, got job %d waiting %s",
+				forecasts[1].Job.ID, forecasts[1].EstimatedWait)
+		}
+	})
+
+	t.Run("jobs missing from an incomplete snapshot have not completed", func(t *testing.T) {
+		forecaster := NewQueueForecaster()
+		runners := []*entity.Runner{busyRunner}
+
+		forecaster.Forecast(snapshot(0, runners, running(1, 0)))
+		incomplete := snapshot(10*time.Minute, runners)
+		incomplete.JobsIncomplete = true
+		forecaster.Forecast(incomplete)
+		forecasts := forecaster.Forecast(snapshot(20*time.Minute, runners, running(1, 0), queued(100, 19*time.Minute)))
+
+		if forecasts[0].Samples != 0 {
+			t.Errorf("expected no completion, got %d", forecasts[0].Samples)
+		}
+	})
+
+	t.Run("forgets completions outside the window", func(t *testing.T) {
+		forecaster := NewQueueForecaster()
+		runners := []*entity.Runner{busyRunner}
+
+		forecaster.Forecast(snapshot(0, runners, running(1, 0)))
+		forecaster.Forecast(snapshot(time.Minute, runners))
+		forecasts := forecaster.Forecast(snapshot(2*time.Hour, runners, queued(100, 2*time.Hour)))
+
+		if forecasts[0].Confidence != value_object.ConfidenceNone {
+			t.Errorf("expected no estimate, got %s", forecasts[0].Confidence)
+		}
+	})
+}
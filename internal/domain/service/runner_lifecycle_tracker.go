@@ -0,0 +1,117 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// RunnerLifecycleTracker follows runners across successive snapshots
+// It remembers when each runner was first seen and its last job, so that runners that disappear
+// can still be shown for the retention time
+type RunnerLifecycleTracker struct {
+	retention time.Duration
+	started   time.Time
+	runners   map[int64]*entity.Runner
+	firstSeen map[int64]time.Time
+	lastJob   map[int64]*entity.Job
+	gone      []*value_object.GoneRunner
+}
+
+// NewRunnerLifecycleTracker creates a new RunnerLifecycleTracker keeping disappeared runners for retention
+func NewRunnerLifecycleTracker(retention time.Duration) *RunnerLifecycleTracker {
+	return &RunnerLifecycleTracker{
+		retention: retention,
+		runners:   make(map[int64]*entity.Runner),
+		firstSeen: make(map[int64]time.Time),
+		lastJob:   make(map[int64]*entity.Job),
+	}
+}
+
+// Track records the snapshot and returns the runners that disappeared within the retention time, most recent first
+func (t *RunnerLifecycleTracker) Track(data *value_object.MonitorData) []*value_object.GoneRunner {
+	if t.started.IsZero() {
+		t.started = data.CurrentTime
+	}
+
+	seen := make(map[int64]bool, len(data.Runners))
+	for _, runner := range data.Runners {
+		seen[runner.ID] = true
+		t.runners[runner.ID] = runner
+		if _, ok := t.firstSeen[runner.ID]; !ok {
+			t.firstSeen[runner.ID] = data.CurrentTime
+		}
+		if job := data.JobIndex.ByRunnerID(runner.ID); job != nil && job.IsRunning() {
+			t.lastJob[runner.ID] = job
+		}
+	}
+
+	// A runner that comes back is no longer gone
+	t.gone = slices.DeleteFunc(t.gone, func(g *value_object.GoneRunner) bool { return seen[g.Runner.ID] })
+
+	for id, runner := range t.runners {
+		if seen[id] {
+			continue
+		}
+		lastJob := t.lastJob[id]
+		if job := data.JobIndex.ByRunnerName(runner.Name); job != nil && job.IsRunning() && (!job.HasRunnerID() || *job.RunnerID == id) {
+			// The runner was removed while its job is still reported, such as an ephemeral runner
+			lastJob = job
+		}
+		t.gone = append(t.gone, &value_object.GoneRunner{
+			Runner:      runner,
+			LastJob:     lastJob,
+			FirstSeen:   t.firstSeen[id],
+			GoneAt:      data.CurrentTime,
+			SeenAtStart: t.firstSeen[id].Equal(t.started),
+		})
+		delete(t.runners, id)
+		delete(t.firstSeen, id)
+		delete(t.lastJob, id)
+	}
+
+	t.gone = slices.DeleteFunc(t.gone, func(g *value_object.GoneRunner) bool {
+		return data.CurrentTime.Sub(g.GoneAt) > t.retention
+	})
+	slices.SortStableFunc(t.gone, func(a, b *value_object.GoneRunner) int {
+		if c := b.GoneAt.Compare(a.GoneAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Runner.Name, b.Runner.Name)
+	})
+	return slices.Clone(t.gone)
+}
+
+// ChurnWindow returns the time the gone runners were collected over at now
+// It is the retention time, or the time since the first snapshot while that is shorter
+func (t *RunnerLifecycleTracker) ChurnWindow(now time.Time) time.Duration {
+	if t.started.IsZero() {
+		return 0
+	}
+	return min(t.retention, now.Sub(t.started))
+}
+
+// CountChurnByLabel counts the gone runners per label over the window, most churning labels first
+func CountChurnByLabel(gone []*value_object.GoneRunner, window time.Duration) []*value_object.LabelChurn {
+	counts := make(map[string]int)
+	for _, g := range gone {
+		for _, label := range g.Runner.Labels {
+			counts[label]++
+		}
+	}
+
+	churn := make([]*value_object.LabelChurn, 0, len(counts))
+	for label, count := range counts {
+		churn = append(churn, &value_object.LabelChurn{Label: label, Count: count, Window: window})
+	}
+	slices.SortFunc(churn, func(a, b *value_object.LabelChurn) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Label, b.Label)
+	})
+	return churn
+}
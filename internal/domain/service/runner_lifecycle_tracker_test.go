@@ -0,0 +1,165 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestRunnerLifecycleTracker(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ephemeral := &entity.Runner{ID: 1, Name: "arc-abcde-runner-11111", Status: entity.StatusIdle, Labels: []string{"arc"}}
+	static := &entity.Runner{ID: 2, Name: "static-01", Status: entity.StatusIdle, Labels: []string{"linux"}}
+	runnerID := int64(1)
+	job := &entity.Job{ID: 100, Name: "build", Status: "in_progress", RunnerID: &runnerID}
+
+	snapshot := func(at time.Duration, runners []*entity.Runner, jobs []*entity.Job) *value_object.MonitorData {
+		return &value_object.MonitorData{
+			CurrentTime: start.Add(at),
+			Runners:     runners,
+			Jobs:        jobs,
+			JobIndex:    value_object.NewJobIndex(runners, jobs),
+		}
+	}
+
+	t.Run("keeps a disappeared runner with its last job and lifetime", func(t *testing.T) {
+		tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+		tracker.Track(snapshot(0, []*entity.Runner{ephemeral, static}, nil))
+		tracker.Track(snapshot(time.Minute, []*entity.Runner{ephemeral, static}, []*entity.Job{job}))
+
+		gone := tracker.Track(snapshot(3*time.Minute, []*entity.Runner{static}, nil))
+
+		if len(gone) != 1 {
+			t.Fatalf("expected 1 gone runner, got %d", len(gone))
+		}
+		if gone[0].Runner.ID != 1 {
+			t.Errorf("expected runner 1 to be gone, got %d", gone[0].Runner.ID)
+		}
+		if gone[0].LastJob != job {
+			t.Errorf("expected the last job to be build, got %v", gone[0].LastJob)
+		}
+		if gone[0].Lifetime() != 3*time.Minute {
+			t.Errorf("expected a lifetime of 3m, got %s", gone[0].Lifetime())
+		}
+		if !gone[0].SeenAtStart {
+			t.Error("expected the lifetime of a runner seen in the first snapshot to be a lower bound")
+		}
+	})
+
+	t.Run("finds the job reported by the name of a removed runner", func(t *testing.T) {
+		name := ephemeral.Name
+		byName := &entity.Job{ID: 101, Name: "deploy", Status: "in_progress", RunnerName: &name}
+		tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+		tracker.Track(snapshot(0, []*entity.Runner{ephemeral, static}, nil))
+
+		gone := tracker.Track(snapshot(time.Minute, []*entity.Runner{static}, []*entity.Job{byName}))
+
+		if len(gone) != 1 || gone[0].LastJob != byName {
+			t.Errorf("expected the last job to be deploy, got %v", gone)
+		}
+	})
+
+	t.Run("knows the lifetime of runners registered while monitoring", func(t *testing.T) {
+		tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+		tracker.Track(snapshot(0, []*entity.Runner{static}, nil))
+		tracker.Track(snapshot(time.Minute, []*entity.Runner{ephemeral, static}, nil))
+
+		gone := tracker.Track(snapshot(4*time.Minute, []*entity.Runner{static}, nil))
+
+		if len(gone) != 1 || gone[0].SeenAtStart || gone[0].Lifetime() != 3*time.Minute {
+			t.Errorf("expected an exact lifetime of 3m, got %v", gone)
+		}
+	})
+
+	t.Run("forgets gone runners after the retention time", func(t *testing.T) {
+		tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+		tracker.Track(snapshot(0, []*entity.Runner{ephemeral, static}, nil))
+		tracker.Track(snapshot(time.Minute, []*entity.Runner{static}, nil))
+
+		if gone := tracker.Track(snapshot(11*time.Minute, []*entity.Runner{static}, nil)); len(gone) != 1 {
+			t.Errorf("expected the runner to be kept for 10m, got %d gone runners", len(gone))
+		}
+		if gone := tracker.Track(snapshot(12*time.Minute, []*entity.Runner{static}, nil)); len(gone) != 0 {
+			t.Errorf("expected the runner to be forgotten, got %d gone runners", len(gone))
+		}
+	})
+
+	t.Run("a runner that comes back is no longer gone", func(t *testing.T) {
+		tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+		tracker.Track(snapshot(0, []*entity.Runner{ephemeral}, nil))
+		tracker.Track(snapshot(time.Minute, nil, nil))
+
+		if gone := tracker.Track(snapshot(2*time.Minute, []*entity.Runner{ephemeral}, nil)); len(gone) != 0 {
+			t.Errorf("expected no gone runners, got %d", len(gone))
+		}
+	})
+
+	t.Run("orders the most recently gone runner first", func(t *testing.T) {
+		tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+		tracker.Track(snapshot(0, []*entity.Runner{ephemeral, static}, nil))
+		tracker.Track(snapshot(time.Minute, []*entity.Runner{static}, nil))
+
+		gone := tracker.Track(snapshot(2*time.Minute, nil, nil))
+
+		if len(gone) != 2 || gone[0].Runner.ID != 2 || gone[1].Runner.ID != 1 {
+			t.Errorf("expected runners 2 and 1, got %v", gone)
+		}
+	})
+}
+
+func TestRunnerLifecycleTracker_ChurnWindow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewRunnerLifecycleTracker(10 * time.Minute)
+
+	if window := tracker.ChurnWindow(start); window != 0 {
+		t.Errorf("expected no window before the first snapshot, got %s", window)
+	}
+
+	tracker.Track(&value_object.MonitorData{CurrentTime: start})
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected time.Duration
+	}{
+		{name: "first snapshot", now: start, expected: 0},
+		{name: "shorter than the retention", now: start.Add(3 * time.Minute), expected: 3 * time.Minute},
+		{name: "longer than the retention", now: start.Add(time.Hour), expected: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if window := tracker.ChurnWindow(tt.now); window != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, window)
+			}
+		})
+	}
+}
+
+func TestCountChurnByLabel(t *testing.T) {
+	gone := []*value_object.GoneRunner{
+		{Runner: &entity.Runner{ID: 1, Labels: []string{"self-hosted", "arc"}}},
+		{Runner: &entity.Runner{ID: 2, Labels: []string{"self-hosted", "arc"}}},
+		{Runner: &entity.Runner{ID: 3, Labels: []string{"self-hosted", "gpu"}}},
+	}
+
+	churn := CountChurnByLabel(gone, 30*time.Minute)
+
+	expected := []value_object.LabelChurn{
+		{Label: "self-hosted", Count: 3, Window: 30 * time.Minute},
+		{Label: "arc", Count: 2, Window: 30 * time.Minute},
+		{Label: "gpu", Count: 1, Window: 30 * time.Minute},
+	}
+	if len(churn) != len(expected) {
+		t.Fatalf("expected %d labels, got %d", len(expected), len(churn))
+	}
+	for i := range expected {
+		if *churn[i] != expected[i] {
+			t.Errorf("expected %v at %d, got %v", expected[i], i, *churn[i])
+		}
+	}
+	if rate := churn[0].PerHour(); rate != 6 {
+		t.Errorf("expected 6 runners per hour, got %v", rate)
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// GroupRunnerPools groups the runners into pools sorted by name
+// Running jobs belong to the pool of their runner, and a queued job is demand for a single pool with a runner
+// carrying all of its labels, so that a job several pools can serve does not inflate each of them
+// Queued jobs without labels belong to no pool
+func GroupRunnerPools(runners []*entity.Runner, jobs []*entity.Job, index *value_object.JobIndex, grouping value_object.PoolGrouping) []*value_object.RunnerPool {
+	pools := make(map[string]*value_object.RunnerPool)
+	for _, runner := range runners {
+		name := poolNameFor(runner, grouping)
+		pool, ok := pools[name]
+		if !ok {
+			pool = &value_object.RunnerPool{Name: name}
+			pools[name] = pool
+		}
+
+		pool.Runners = append(pool.Runners, runner)
+		for _, label := range runner.Labels {
+			if !slices.Contains(pool.Labels, label) {
+				pool.Labels = append(pool.Labels, label)
+			}
+		}
+
+		if !runner.IsOnline() {
+			pool.Offline++
+			continue
+		}
+		pool.Current++
+		if runner.IsBusy() {
+			pool.Busy++
+		}
+		if job := index.ByRunnerID(runner.ID); job != nil && job.IsRunning() {
+			pool.RunningJobs = append(pool.RunningJobs, job)
+		}
+	}
+
+	result := make([]*value_object.RunnerPool, 0, len(pools))
+	for _, pool := range pools {
+		result = append(result, pool)
+	}
+	slices.SortFunc(result, func(a, b *value_object.RunnerPool) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, job := range jobs {
+		if !job.IsQueued() || len(job.Labels) == 0 {
+			continue
+		}
+		if pool := assignQueuedJob(result, job); pool != nil {
+			pool.QueuedJobs = append(pool.QueuedJobs, job)
+		}
+	}
+	return result
+}
+
+// assignQueuedJob picks the pool a queued job is counted for, or nil if no pool can serve it
+// The most specific pool wins, the one whose matching runner has the fewest labels beyond those of the job,
+// then the pool with the most idle runners, then the first pool by name
+func assignQueuedJob(pools []*value_object.RunnerPool, job *entity.Job) *value_object.RunnerPool {
+	var assigned *value_object.RunnerPool
+	assignedLabels := 0
+	for _, pool := range pools {
+		labels, ok := servingLabelCount(pool, job)
+		if !ok {
+			continue
+		}
+		if assigned == nil || labels < assignedLabels || labels == assignedLabels && pool.Idle() > assigned.Idle() {
+			assigned = pool
+			assignedLabels = labels
+		}
+	}
+	return assigned
+}
+
+// servingLabelCount returns the fewest labels of a runner of the pool that carries every label of the job
+// The labels of the pool are the union over its runners, which no runner may have on its own
+func servingLabelCount(pool *value_object.RunnerPool, job *entity.Job) (int, bool) {
+	count, ok := 0, false
+	for _, runner := range pool.Runners {
+		if hasAllLabels(runner.Labels, job.Labels) && (!ok || len(runner.Labels) < count) {
+			count, ok = len(runner.Labels), true
+		}
+	}
+	return count, ok
+}
+
+// poolNameFor returns the name of the pool the runner belongs to
+func poolNameFor(runner *entity.Runner, grouping value_object.PoolGrouping) string {
+	switch grouping {
+	case value_object.PoolGroupingLabels:
+		return labelSetKey(runner.Labels)
+	case value_object.PoolGroupingPrefix:
+		return namePrefix(runner.Name)
+	default:
+		return runner.Name
+	}
+}
+
+// namePrefix strips the generated suffix from a runner name
+// Scale set runners are named <scale set>-<id>-runner-<id>, and other runners usually end with a number or a hash
+// Segments such as x64 or arm64 are kept, so that runners of different architectures stay apart
+func namePrefix(name string) string {
+	parts := strings.Split(name, "-")
+	if len(parts) >= 4 && parts[len(parts)-2] == "runner" {
+		return strings.Join(parts[:len(parts)-3], "-")
+	}
+	for len(parts) > 1 && isGeneratedSuffix(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, "-")
+}
+
+// kubernetesSuffixAlphabet is the alphabet Kubernetes uses for generated pod and replica set name suffixes
+const kubernetesSuffixAlphabet = "bcdfghjklmnpqrstvwxz2456789"
+
+// isGeneratedSuffix returns true if the name segment is a number, a Kubernetes generated suffix or a hex hash
+func isGeneratedSuffix(segment string) bool {
+	if segment == "" || !strings.ContainsAny(segment, "0123456789") {
+		return false
+	}
+	if strings.Trim(segment, "0123456789") == "" {
+		return true
+	}
+	if len(segment) >= 5 && strings.Trim(segment, kubernetesSuffixAlphabet) == "" {
+		return true
+	}
+	return len(segment) >= 7 && strings.Trim(segment, "0123456789abcdef") == ""
+}
+
+// hasAllLabels returns true if labels contains every required label
+func hasAllLabels(labels, required []string) bool {
+	for _, label := range required {
+		if !slices.Contains(labels, label) {
+			return false
+		}
+	}
+	return true
+}
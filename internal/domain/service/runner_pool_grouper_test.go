@@ -0,0 +1,176 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestNamePrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "scale set runner", input: "arc-runner-set-7v4qx-runner-2xgwm", expected: "arc-runner-set"},
+		{name: "numbered runner", input: "linux-runner-01", expected: "linux-runner"},
+		{name: "hashed runner", input: "build-5f8d9c7b4-x2k9p", expected: "build"},
+		{name: "hex hash", input: "ci-runner-3fa85f64", expected: "ci-runner"},
+		{name: "architecture", input: "linux-x64-1", expected: "linux-x64"},
+		{name: "architecture with hash", input: "linux-arm64-7v4qx", expected: "linux-arm64"},
+		{name: "plain name", input: "mac-mini", expected: "mac-mini"},
+		{name: "number only", input: "42", expected: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := namePrefix(tt.input); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestGroupRunnerPools(t *testing.T) {
+	runners := []*entity.Runner{
+		{ID: 1, Name: "arc-linux-abcde-runner-11111", Status: entity.StatusActive, Labels: []string{"arc-linux"}},
+		{ID: 2, Name: "arc-linux-abcde-runner-22222", Status: entity.StatusIdle, Labels: []string{"arc-linux"}},
+		{ID: 3, Name: "arc-gpu-fghij-runner-33333", Status: entity.StatusBusy, Labels: []string{"arc-gpu"}},
+		{ID: 4, Name: "arc-gpu-fghij-runner-44444", Status: entity.StatusOffline, Labels: []string{"arc-gpu"}},
+	}
+	runnerID := int64(1)
+	jobs := []*entity.Job{
+		{ID: 100, Status: "in_progress", RunnerID: &runnerID, Labels: []string{"arc-linux"}},
+		{ID: 101, Status: "queued", Labels: []string{"arc-linux"}},
+		{ID: 102, Status: "queued", Labels: []string{"arc-gpu"}},
+		{ID: 103, Status: "queued", Labels: []string{"arc-gpu"}},
+		{ID: 104, Status: "queued", Labels: []string{"windows"}},
+		{ID: 105, Status: "queued"},
+	}
+	index := value_object.NewJobIndex(runners, jobs)
+
+	t.Run("group by name prefix", func(t *testing.T) {
+		pools := GroupRunnerPools(runners, jobs, index, value_object.PoolGroupingPrefix)
+
+		if len(pools) != 2 {
+			t.Fatalf("expected 2 pools, got %d", len(pools))
+		}
+
+		gpu, linux := pools[0], pools[1]
+		if gpu.Name != "arc-gpu" || linux.Name != "arc-linux" {
+			t.Fatalf("expected pools arc-gpu and arc-linux, got %s and %s", gpu.Name, linux.Name)
+		}
+
+		if linux.Current != 2 || linux.Busy != 1 || linux.Idle() != 1 || linux.Offline != 0 {
+			t.Errorf("unexpected arc-linux counts: current %d, busy %d, idle %d, offline %d",
+				linux.Current, linux.Busy, linux.Idle(), linux.Offline)
+		}
+		if len(linux.RunningJobs) != 1 || linux.RunningJobs[0].ID != 100 {
+			t.Errorf("expected job 100 running in arc-linux, got %v", linux.RunningJobs)
+		}
+		if linux.Desired() != 2 {
+			t.Errorf("expected arc-linux to desire 2 runners, got %d", linux.Desired())
+		}
+
+		if gpu.Current != 1 || gpu.Busy != 1 || gpu.Offline != 1 {
+			t.Errorf("unexpected arc-gpu counts: current %d, busy %d, offline %d", gpu.Current, gpu.Busy, gpu.Offline)
+		}
+		if len(gpu.RunningJobs) != 0 {
+			t.Errorf("expected no visible running job in arc-gpu, got %d", len(gpu.RunningJobs))
+		}
+		if len(gpu.QueuedJobs) != 2 || gpu.Desired() != 3 {
+			t.Errorf("expected 2 queued jobs and 3 desired runners in arc-gpu, got %d and %d", len(gpu.QueuedJobs), gpu.Desired())
+		}
+	})
+
+	t.Run("group by labels", func(t *testing.T) {
+		labelled := []*entity.Runner{
+			{ID: 1, Name: "runner-a", Status: entity.StatusIdle, Labels: []string{"linux", "self-hosted"}},
+			{ID: 2, Name: "runner-b", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux"}},
+			{ID: 3, Name: "runner-c", Status: entity.StatusIdle, Labels: []string{"self-hosted", "macos"}},
+		}
+
+		pools := GroupRunnerPools(labelled, nil, value_object.NewJobIndex(labelled, nil), value_object.PoolGroupingLabels)
+
+		if len(pools) != 2 {
+			t.Fatalf("expected 2 pools, got %d", len(pools))
+		}
+		if pools[0].Name != "linux,self-hosted" || len(pools[0].Runners) != 2 {
+			t.Errorf("expected 2 runners in linux,self-hosted, got %d in %s", len(pools[0].Runners), pools[0].Name)
+		}
+	})
+
+	t.Run("queued jobs need a single runner with all labels", func(t *testing.T) {
+		mixed := []*entity.Runner{
+			{ID: 1, Name: "linux-1", Status: entity.StatusIdle, Labels: []string{"linux", "gpu"}},
+			{ID: 2, Name: "linux-2", Status: entity.StatusIdle, Labels: []string{"linux", "x64"}},
+		}
+		queued := []*entity.Job{
+			{ID: 200, Status: "queued", Labels: []string{"gpu", "x64"}},
+			{ID: 201, Status: "queued", Labels: []string{"linux", "gpu"}},
+		}
+
+		pools := GroupRunnerPools(mixed, queued, value_object.NewJobIndex(mixed, queued), value_object.PoolGroupingPrefix)
+
+		if len(pools) != 1 {
+			t.Fatalf("expected 1 pool, got %d", len(pools))
+		}
+		if len(pools[0].QueuedJobs) != 1 || pools[0].QueuedJobs[0].ID != 201 {
+			t.Errorf("expected only job 201 to be queued for the pool, got %v", pools[0].QueuedJobs)
+		}
+	})
+
+	t.Run("queued jobs count for a single pool", func(t *testing.T) {
+		architectures := []*entity.Runner{
+			{ID: 1, Name: "linux-x64-1", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux", "x64"}},
+			{ID: 2, Name: "linux-arm64-1", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux", "arm64"}},
+			{ID: 3, Name: "linux-arm64-2", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux", "arm64"}},
+			{ID: 4, Name: "linux-1", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux"}},
+		}
+		queued := []*entity.Job{
+			{ID: 300, Status: "queued", Labels: []string{"self-hosted", "linux"}},
+			{ID: 301, Status: "queued", Labels: []string{"self-hosted", "arm64"}},
+			{ID: 302, Status: "queued", Labels: []string{"self-hosted", "x64"}},
+		}
+
+		pools := GroupRunnerPools(architectures, queued, value_object.NewJobIndex(architectures, queued), value_object.PoolGroupingPrefix)
+
+		if len(pools) != 3 {
+			t.Fatalf("expected the architectures in separate pools, got %d", len(pools))
+		}
+		linux, arm, x64 := pools[0], pools[1], pools[2]
+		if linux.Name != "linux" || arm.Name != "linux-arm64" || x64.Name != "linux-x64" {
+			t.Fatalf("expected pools linux, linux-arm64 and linux-x64, got %s, %s and %s", linux.Name, arm.Name, x64.Name)
+		}
+		// Job 300 can run in every pool, the pool without extra labels is the most specific
+		if len(linux.QueuedJobs) != 1 || linux.QueuedJobs[0].ID != 300 {
+			t.Errorf("expected job 300 queued in linux, got %v", linux.QueuedJobs)
+		}
+		if len(arm.QueuedJobs) != 1 || arm.QueuedJobs[0].ID != 301 {
+			t.Errorf("expected job 301 queued in linux-arm64, got %v", arm.QueuedJobs)
+		}
+		if len(x64.QueuedJobs) != 1 || x64.QueuedJobs[0].ID != 302 {
+			t.Errorf("expected job 302 queued in linux-x64, got %v", x64.QueuedJobs)
+		}
+	})
+
+	t.Run("equally specific pools prefer the most idle runners", func(t *testing.T) {
+		architectures := []*entity.Runner{
+			{ID: 1, Name: "linux-x64-1", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux", "x64"}},
+			{ID: 2, Name: "linux-arm64-1", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux", "arm64"}},
+			{ID: 3, Name: "linux-arm64-2", Status: entity.StatusIdle, Labels: []string{"self-hosted", "linux", "arm64"}},
+		}
+		queued := []*entity.Job{{ID: 300, Status: "queued", Labels: []string{"self-hosted", "linux"}}}
+
+		pools := GroupRunnerPools(architectures, queued, value_object.NewJobIndex(architectures, queued), value_object.PoolGroupingPrefix)
+
+		arm, x64 := pools[0], pools[1]
+		if len(arm.QueuedJobs) != 1 || len(x64.QueuedJobs) != 0 {
+			t.Errorf("expected job 300 queued only in linux-arm64, got %d and %d", len(arm.QueuedJobs), len(x64.QueuedJobs))
+		}
+		if total := arm.Desired() + x64.Desired(); total != 1 {
+			t.Errorf("expected a total of 1 desired runner, got %d", total)
+		}
+	})
+}
@@ -2,23 +2,18 @@ package service
 
 import (
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
 )
 
 // UpdateRunnerStatus updates the runner status based on active jobs
-// If a runner has an active job, its status is set to Active
-func UpdateRunnerStatus(runners []*entity.Runner, jobs []*entity.Job) {
+// A runner with a running job is Active, and a runner reported busy without a visible job is Busy
+func UpdateRunnerStatus(runners []*entity.Runner, index *value_object.JobIndex) {
 	for _, runner := range runners {
-		// Reset to idle if the runner is online but not assigned to any job
-		if runner.IsOnline() && !runner.IsActive() {
-			runner.Status = entity.StatusIdle
-		}
-
-		// Check if this runner has an active job
-		for _, job := range jobs {
-			if job.IsAssignedToRunner(runner.ID) && job.IsRunning() {
-				runner.Status = entity.StatusActive
-				break
-			}
+		switch job := index.ByRunnerID(runner.ID); {
+		case job != nil && job.IsRunning():
+			runner.Status = entity.StatusActive
+		case runner.IsBusy():
+			runner.Status = entity.StatusBusy
 		}
 	}
 }
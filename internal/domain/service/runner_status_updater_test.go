@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
 )
 
 func TestUpdateRunnerStatus(t *testing.T) {
@@ -18,7 +19,7 @@ func TestUpdateRunnerStatus(t *testing.T) {
 			{ID: 100, Name: "build", Status: "in_progress", RunnerID: &runnerID},
 		}
 
-		UpdateRunnerStatus(runners, jobs)
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
 
 		if runners[0].Status != entity.StatusActive {
 			t.Errorf("expected runner-1 to be Active, got %s", runners[0].Status)
@@ -29,6 +30,122 @@ func TestUpdateRunnerStatus(t *testing.T) {
 		}
 	})
 
+	t.Run("running job wins over a finished job on the same runner", func(t *testing.T) {
+		runners := []*entity.Runner{
+			{ID: 1, Name: "runner-1", Status: entity.StatusIdle},
+		}
+
+		runnerID := int64(1)
+		jobs := []*entity.Job{
+			{ID: 100, Name: "build", Status: "completed", RunnerID: &runnerID},
+			{ID: 101, Name: "test", Status: "in_progress", RunnerID: &runnerID},
+		}
+
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
+
+		if runners[0].Status != entity.StatusActive {
+			t.Errorf("expected runner-1 to be Active, got %s", runners[0].Status)
+		}
+	})
+
+	t.Run("job reported by runner name only", func(t *testing.T) {
+		runners := []*entity.Runner{
+			{ID: 1, Name: "runner-1", Status: entity.StatusIdle},
+			{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+		}
+
+		zeroRunnerID := int64(0)
+		runnerName := "runner-2"
+		jobs := []*entity.Job{
+			{ID: 100, Name: "build", Status: "in_progress", RunnerID: &zeroRunnerID, RunnerName: &runnerName},
+		}
+
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
+
+		if runners[0].Status != entity.StatusIdle {
+			t.Errorf("expected runner-1 to be Idle, got %s", runners[0].Status)
+		}
+		if runners[1].Status != entity.StatusActive {
+			t.Errorf("expected runner-2 to be Active, got %s", runners[1].Status)
+		}
+	})
+
+	t.Run("reused runner name prefers the online runner", func(t *testing.T) {
+		runners := []*entity.Runner{
+			{ID: 1, Name: "ephemeral", Status: entity.StatusOffline},
+			{ID: 2, Name: "ephemeral", Status: entity.StatusIdle},
+		}
+
+		runnerName := "ephemeral"
+		jobs := []*entity.Job{
+			{ID: 100, Name: "build", Status: "in_progress", RunnerName: &runnerName},
+		}
+
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
+
+		if runners[0].Status != entity.StatusOffline {
+			t.Errorf("expected the stale runner to stay Offline, got %s", runners[0].Status)
+		}
+		if runners[1].Status != entity.StatusActive {
+			t.Errorf("expected the online runner to be Active, got %s", runners[1].Status)
+		}
+	})
+
+	t.Run("reused runner name prefers the newest online runner", func(t *testing.T) {
+		runners := []*entity.Runner{
+			{ID: 5, Name: "ephemeral", Status: entity.StatusIdle},
+			{ID: 3, Name: "ephemeral", Status: entity.StatusIdle},
+		}
+
+		runnerName := "ephemeral"
+		jobs := []*entity.Job{
+			{ID: 100, Name: "build", Status: "in_progress", RunnerName: &runnerName},
+		}
+
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
+
+		if runners[0].Status != entity.StatusActive {
+			t.Errorf("expected runner 5 to be Active, got %s", runners[0].Status)
+		}
+		if runners[1].Status != entity.StatusIdle {
+			t.Errorf("expected runner 3 to be Idle, got %s", runners[1].Status)
+		}
+	})
+
+	t.Run("runner ID wins over a reused runner name", func(t *testing.T) {
+		runners := []*entity.Runner{
+			{ID: 1, Name: "ephemeral", Status: entity.StatusIdle},
+			{ID: 2, Name: "ephemeral", Status: entity.StatusIdle},
+		}
+
+		runnerID := int64(1)
+		runnerName := "ephemeral"
+		jobs := []*entity.Job{
+			{ID: 100, Name: "build", Status: "in_progress", RunnerID: &runnerID, RunnerName: &runnerName},
+		}
+
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
+
+		if runners[0].Status != entity.StatusActive {
+			t.Errorf("expected runner 1 to be Active, got %s", runners[0].Status)
+		}
+		if runners[1].Status != entity.StatusIdle {
+			t.Errorf("expected runner 2 to be Idle, got %s", runners[1].Status)
+		}
+	})
+
+	t.Run("busy runner without a visible job", func(t *testing.T) {
+		runners := []*entity.Runner{
+			{ID: 1, Name: "runner-1", Status: entity.StatusActive},
+		}
+
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, nil))
+
+		if runners[0].Status != entity.StatusBusy {
+			t.Errorf("expected runner-1 to be Busy, got %s", runners[0].Status)
+		}
+	})
+
 	t.Run("no active jobs", func(t *testing.T) {
 		runners := []*entity.Runner{
 			{ID: 1, Name: "runner-1", Status: entity.StatusIdle},
@@ -36,7 +153,7 @@ func TestUpdateRunnerStatus(t *testing.T) {
 
 		jobs := []*entity.Job{}
 
-		UpdateRunnerStatus(runners, jobs)
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
 
 		if runners[0].Status != entity.StatusIdle {
 			t.Errorf("expected runner-1 to remain Idle, got %s", runners[0].Status)
@@ -53,7 +170,7 @@ func TestUpdateRunnerStatus(t *testing.T) {
 			{ID: 100, Name: "build", Status: "in_progress", RunnerID: &runnerID},
 		}
 
-		UpdateRunnerStatus(runners, jobs)
+		UpdateRunnerStatus(runners, value_object.NewJobIndex(runners, jobs))
 
 		// Offline runner should become active if it has a job
 		if runners[0].Status != entity.StatusActive {
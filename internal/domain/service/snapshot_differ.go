@@ -0,0 +1,141 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// DiffSnapshots returns the transitions between the previous and the current snapshot
+// No events are reported for the first snapshot, and jobs are not compared when either snapshot misses some jobs
+func DiffSnapshots(prev, curr *value_object.MonitorData) []*value_object.Event {
+	if prev == nil || curr == nil {
+		return nil
+	}
+
+	events := diffRunners(prev, curr)
+	if prev.JobsIncomplete || curr.JobsIncomplete {
+		return events
+	}
+	return append(events, diffJobs(prev, curr)...)
+}
+
+// diffRunners reports runners that were added, removed, or changed status or labels
+func diffRunners(prev, curr *value_object.MonitorData) []*value_object.Event {
+	var events []*value_object.Event
+	newEvent := func(eventType value_object.EventType, runner *entity.Runner, message string) {
+		events = append(events, &value_object.Event{
+			Type:       eventType,
+			At:         curr.CurrentTime,
+			RunnerName: runner.Name,
+			Message:    message,
+		})
+	}
+
+	prevRunners := make(map[int64]*entity.Runner, len(prev.Runners))
+	for _, runner := range prev.Runners {
+		prevRunners[runner.ID] = runner
+	}
+	currRunners := make(map[int64]bool, len(curr.Runners))
+
+	for _, runner := range curr.Runners {
+		currRunners[runner.ID] = true
+		before, ok := prevRunners[runner.ID]
+		if !ok {
+			newEvent(value_object.EventRunnerAdded, runner, fmt.Sprintf("Runner %s was added (%s)", runner.Name, runner.Status))
+			continue
+		}
+
+		switch {
+		case before.IsOnline() && !runner.IsOnline():
+			newEvent(value_object.EventRunnerOffline, runner, fmt.Sprintf("Runner %s went offline", runner.Name))
+		case !before.IsOnline() && runner.IsOnline():
+			newEvent(value_object.EventRunnerOnline, runner, fmt.Sprintf("Runner %s came back online", runner.Name))
+		case before.Status != runner.Status:
+			newEvent(value_object.EventRunnerStatusChanged, runner,
+				fmt.Sprintf("Runner %s changed from %s to %s", runner.Name, before.Status, runner.Status))
+		}
+
+		if !sameLabels(before.Labels, runner.Labels) {
+			newEvent(value_object.EventRunnerLabelsChanged, runner,
+				fmt.Sprintf("Runner %s labels changed: [%s] -> [%s]", runner.Name,
+					strings.Join(before.Labels, ", "), strings.Join(runner.Labels, ", ")))
+		}
+	}
+
+	for _, runner := range prev.Runners {
+		if !currRunners[runner.ID] {
+			newEvent(value_object.EventRunnerRemoved, runner, fmt.Sprintf("Runner %s was removed", runner.Name))
+		}
+	}
+
+	return events
+}
+
+// diffJobs reports jobs that were queued, started on a runner, or completed
+func diffJobs(prev, curr *value_object.MonitorData) []*value_object.Event {
+	var events []*value_object.Event
+	newEvent := func(eventType value_object.EventType, job *entity.Job, message string) {
+		events = append(events, &value_object.Event{
+			Type:       eventType,
+			At:         curr.CurrentTime,
+			RunnerName: jobRunnerName(job),
+			JobName:    job.Name,
+			Message:    message,
+		})
+	}
+
+	prevJobs := make(map[int64]*entity.Job, len(prev.Jobs))
+	for _, job := range prev.Jobs {
+		prevJobs[job.ID] = job
+	}
+	currJobs := make(map[int64]*entity.Job, len(curr.Jobs))
+	for _, job := range curr.Jobs {
+		currJobs[job.ID] = job
+	}
+
+	for _, job := range curr.Jobs {
+		before, existed := prevJobs[job.ID]
+		switch {
+		case job.IsQueued() && !existed:
+			newEvent(value_object.EventJobQueued, job, fmt.Sprintf("Job %s (%s) was queued", job.Name, job.WorkflowName))
+		case job.IsRunning() && (!existed || !before.IsRunning()):
+			newEvent(value_object.EventJobStarted, job,
+				fmt.Sprintf("Job %s (%s) started on %s", job.Name, job.WorkflowName, jobRunnerName(job)))
+		}
+	}
+
+	for _, job := range prev.Jobs {
+		if !job.IsRunning() {
+			continue
+		}
+		if after, ok := currJobs[job.ID]; ok && after.IsRunning() {
+			continue
+		}
+		newEvent(value_object.EventJobFinished, job,
+			fmt.Sprintf("Job %s (%s) finished on %s", job.Name, job.WorkflowName, jobRunnerName(job)))
+	}
+
+	return events
+}
+
+// sameLabels reports whether two label sets contain the same labels regardless of order
+func sameLabels(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := slices.Sorted(slices.Values(a))
+	sortedB := slices.Sorted(slices.Values(b))
+	return slices.Equal(sortedA, sortedB)
+}
+
+// jobRunnerName returns the name of the runner executing the job, or "-" if unknown
+func jobRunnerName(job *entity.Job) string {
+	if job.RunnerName == nil || *job.RunnerName == "" {
+		return "-"
+	}
+	return *job.RunnerName
+}
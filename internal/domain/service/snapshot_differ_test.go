@@ -0,0 +1,143 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	runnerName := "runner-1"
+
+	t.Run("no events for the first snapshot", func(t *testing.T) {
+		curr := &value_object.MonitorData{CurrentTime: now}
+		if events := DiffSnapshots(nil, curr); len(events) != 0 {
+			t.Errorf("expected no events, got %d", len(events))
+		}
+	})
+
+	t.Run("detects runner and job transitions", func(t *testing.T) {
+		prev := &value_object.MonitorData{
+			CurrentTime: now,
+			Runners: []*entity.Runner{
+				{ID: 1, Name: "runner-1", Status: entity.StatusActive},
+				{ID: 2, Name: "runner-2", Status: entity.StatusOffline},
+			},
+			Jobs: []*entity.Job{
+				{ID: 10, Name: "build", Status: "in_progress", RunnerName: &runnerName},
+				{ID: 11, Name: "test", Status: "queued"},
+			},
+		}
+		curr := &value_object.MonitorData{
+			CurrentTime: now.Add(5 * time.Second),
+			Runners: []*entity.Runner{
+				{ID: 1, Name: "runner-1", Status: entity.StatusOffline},
+				{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+			},
+			Jobs: []*entity.Job{
+				{ID: 11, Name: "test", Status: "in_progress", RunnerName: &runnerName},
+			},
+		}
+
+		events := DiffSnapshots(prev, curr)
+
+		expected := []value_object.EventType{
+			value_object.EventRunnerOffline,
+			value_object.EventRunnerOnline,
+			value_object.EventJobStarted,
+			value_object.EventJobFinished,
+		}
+		if len(events) != len(expected) {
+			t.Fatalf("expected %d events, got %d", len(expected), len(events))
+		}
+		for i, e := range expected {
+			if events[i].Type != e {
+				t.Errorf("event %d: expected %s, got %s", i, e, events[i].Type)
+			}
+			if !events[i].At.Equal(curr.CurrentTime) {
+				t.Errorf("event %d: expected timestamp of current snapshot", i)
+			}
+		}
+	})
+
+	t.Run("jobs are not compared when a snapshot misses jobs", func(t *testing.T) {
+		complete := &value_object.MonitorData{
+			CurrentTime: now,
+			Runners:     []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusActive}},
+			Jobs:        []*entity.Job{{ID: 10, Name: "build", Status: "in_progress", RunnerName: &runnerName}},
+		}
+		incomplete := &value_object.MonitorData{
+			CurrentTime:    now.Add(5 * time.Second),
+			Runners:        []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusOffline}},
+			JobsIncomplete: true,
+		}
+
+		events := DiffSnapshots(complete, incomplete)
+		if len(events) != 1 || events[0].Type != value_object.EventRunnerOffline {
+			t.Errorf("expected only the runner transition, got %v", events)
+		}
+		if events := DiffSnapshots(incomplete, complete); len(events) != 1 || events[0].Type != value_object.EventRunnerOnline {
+			t.Errorf("expected only the runner transition, got %v", events)
+		}
+	})
+
+	t.Run("no events when nothing changed", func(t *testing.T) {
+		data := &value_object.MonitorData{
+			CurrentTime: now,
+			Runners:     []*entity.Runner{{ID: 1, Status: entity.StatusIdle}},
+			Jobs:        []*entity.Job{{ID: 10, Status: "in_progress"}},
+		}
+		if events := DiffSnapshots(data, data); len(events) != 0 {
+			t.Errorf("expected no events, got %d", len(events))
+		}
+	})
+	t.Run("detects added, removed, status and label changes and queued jobs", func(t *testing.T) {
+		prev := &value_object.MonitorData{
+			CurrentTime: now,
+			Runners: []*entity.Runner{
+				{ID: 1, Name: "runner-1", Status: entity.StatusIdle, Labels: []string{"linux", "x64"}},
+				{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+			},
+		}
+		curr := &value_object.MonitorData{
+			CurrentTime: now.Add(5 * time.Second),
+			Runners: []*entity.Runner{
+				{ID: 1, Name: "runner-1", Status: entity.StatusActive, Labels: []string{"linux", "gpu"}},
+				{ID: 3, Name: "runner-3", Status: entity.StatusIdle},
+			},
+			Jobs: []*entity.Job{
+				{ID: 20, Name: "deploy", Status: "queued"},
+			},
+		}
+
+		events := DiffSnapshots(prev, curr)
+
+		expected := []value_object.EventType{
+			value_object.EventRunnerStatusChanged,
+			value_object.EventRunnerLabelsChanged,
+			value_object.EventRunnerAdded,
+			value_object.EventRunnerRemoved,
+			value_object.EventJobQueued,
+		}
+		if len(events) != len(expected) {
+			t.Fatalf("expected %d events, got %d", len(expected), len(events))
+		}
+		for i, e := range expected {
+			if events[i].Type != e {
+				t.Errorf("event %d: expected %s, got %s", i, e, events[i].Type)
+			}
+		}
+	})
+}
+
+func TestSameLabels(t *testing.T) {
+	if !sameLabels([]string{"a", "b"}, []string{"b", "a"}) {
+		t.Error("expected labels in different order to be equal")
+	}
+	if sameLabels([]string{"a"}, []string{"a", "b"}) {
+		t.Error("expected different label sets not to be equal")
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// ReconcileRunnerStatus reports the runners whose status reported by the runner API disagrees with the job data
+// It compares the reported status, so it must be called before UpdateRunnerStatus
+func ReconcileRunnerStatus(runners []*entity.Runner, index *value_object.JobIndex) []*value_object.StatusMismatch {
+	var mismatches []*value_object.StatusMismatch
+	for _, runner := range runners {
+		job := index.ByRunnerID(runner.ID)
+		if job != nil && !job.IsRunning() {
+			job = nil
+		}
+
+		var reason value_object.MismatchReason
+		switch {
+		case job == nil && runner.IsBusy():
+			reason = value_object.MismatchBusyWithoutJob
+		case job != nil && runner.Status == entity.StatusIdle:
+			reason = value_object.MismatchIdleWithJob
+		case job != nil && runner.Status == entity.StatusOffline:
+			reason = value_object.MismatchOfflineWithJob
+		default:
+			continue
+		}
+
+		mismatches = append(mismatches, &value_object.StatusMismatch{
+			Runner: runner,
+			Job:    job,
+			Reason: reason,
+		})
+	}
+	return mismatches
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestReconcileRunnerStatus(t *testing.T) {
+	runnerID := int64(1)
+
+	tests := []struct {
+		name     string
+		status   entity.RunnerStatus
+		jobs     []*entity.Job
+		expected value_object.MismatchReason
+	}{
+		{
+			name:     "busy runner with running job",
+			status:   entity.StatusActive,
+			jobs:     []*entity.Job{{ID: 100, Status: "in_progress", RunnerID: &runnerID}},
+			expected: "",
+		},
+		{
+			name:     "busy runner without job",
+			status:   entity.StatusActive,
+			expected: value_object.MismatchBusyWithoutJob,
+		},
+		{
+			name:     "busy runner with queued job",
+			status:   entity.StatusActive,
+			jobs:     []*entity.Job{{ID: 100, Status: "queued", RunnerID: &runnerID}},
+			expected: value_object.MismatchBusyWithoutJob,
+		},
+		{
+			name:     "idle runner without job",
+			status:   entity.StatusIdle,
+			expected: "",
+		},
+		{
+			name:     "idle runner with running job",
+			status:   entity.StatusIdle,
+			jobs:     []*entity.Job{{ID: 100, Status: "in_progress", RunnerID: &runnerID}},
+			expected: value_object.MismatchIdleWithJob,
+		},
+		{
+			name:     "offline runner with running job",
+			status:   entity.StatusOffline,
+			jobs:     []*entity.Job{{ID: 100, Status: "in_progress", RunnerID: &runnerID}},
+			expected: value_object.MismatchOfflineWithJob,
+		},
+		{
+			name:     "offline runner without job",
+			status:   entity.StatusOffline,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runners := []*entity.Runner{{ID: runnerID, Name: "runner-1", Status: tt.status}}
+
+			mismatches := ReconcileRunnerStatus(runners, value_object.NewJobIndex(runners, tt.jobs))
+
+			if tt.expected == "" {
+				if len(mismatches) != 0 {
+					t.Errorf("expected no mismatch, got %s", mismatches[0].Reason)
+				}
+				return
+			}
+			if len(mismatches) != 1 {
+				t.Fatalf("expected 1 mismatch, got %d", len(mismatches))
+			}
+			if mismatches[0].Reason != tt.expected {
+				t.Errorf("expected reason %s, got %s", tt.expected, mismatches[0].Reason)
+			}
+			if mismatches[0].Runner != runners[0] {
+				t.Error("expected the mismatch to refer to the runner")
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// DetectStuckJobs returns the jobs that have been running or queued longer than the thresholds
+func DetectStuckJobs(jobs []*entity.Job, currentTime time.Time, thresholds value_object.JobThresholds) []*value_object.FlaggedJob {
+	var flagged []*value_object.FlaggedJob
+	for _, job := range jobs {
+		switch {
+		case job.IsRunning():
+			threshold := runningThresholdFor(job, thresholds)
+			duration := job.GetExecutionDurationAt(currentTime)
+			if threshold > 0 && duration > threshold {
+				flagged = append(flagged, &value_object.FlaggedJob{
+					Job:       job,
+					Reason:    value_object.FlagLongRunning,
+					Duration:  duration,
+					Threshold: threshold,
+				})
+			}
+		case job.IsQueued():
+			duration := job.GetQueuedDurationAt(currentTime)
+			if thresholds.LongQueued > 0 && duration > thresholds.LongQueued {
+				flagged = append(flagged, &value_object.FlaggedJob{
+					Job:       job,
+					Reason:    value_object.FlagLongQueued,
+					Duration:  duration,
+					Threshold: thresholds.LongQueued,
+				})
+			}
+		}
+	}
+	return flagged
+}
+
+// runningThresholdFor resolves the running threshold for a job
+// A workflow threshold takes precedence over label thresholds, and the
+// smallest matching label threshold wins over the global one
+func runningThresholdFor(job *entity.Job, thresholds value_object.JobThresholds) time.Duration {
+	if threshold, ok := thresholds.LongRunningByWorkflow[job.WorkflowName]; ok {
+		return threshold
+	}
+
+	var labelThreshold time.Duration
+	found := false
+	for _, label := range job.Labels {
+		threshold, ok := thresholds.LongRunningByLabel[label]
+		if !ok {
+			continue
+		}
+		if !found || threshold < labelThreshold {
+			labelThreshold = threshold
+			found = true
+		}
+	}
+	if found {
+		return labelThreshold
+	}
+
+	return thresholds.LongRunning
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestDetectStuckJobs(t *testing.T) {
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+
+	thresholds := value_object.JobThresholds{
+		LongRunning:           time.Hour,
+		LongRunningByWorkflow: map[string]time.Duration{"Nightly": 3 * time.Hour},
+		LongRunningByLabel:    map[string]time.Duration{"gpu": 4 * time.Hour, "short": 10 * time.Minute},
+		LongQueued:            10 * time.Minute,
+	}
+
+	tests := []struct {
+		name       string
+		job        *entity.Job
+		wantReason value_object.FlagReason
+		wantLimit  time.Duration
+	}{
+		{
+			name:       "running longer than global threshold",
+			job:        &entity.Job{ID: 1, Status: "in_progress", StartedAt: ago(2 * time.Hour), WorkflowName: "CI"},
+			wantReason: value_object.FlagLongRunning,
+			wantLimit:  time.Hour,
+		},
+		{
+			name: "running within global threshold",
+			job:  &entity.Job{ID: 2, Status: "in_progress", StartedAt: ago(30 * time.Minute), WorkflowName: "CI"},
+		},
+		{
+			name: "workflow threshold takes precedence",
+			job:  &entity.Job{ID: 3, Status: "in_progress", StartedAt: ago(2 * time.Hour), WorkflowName: "Nightly", Labels: []string{"short"}},
+		},
+		{
+			name: "label threshold overrides global",
+			job:  &entity.Job{ID: 4, Status: "in_progress", StartedAt: ago(2 * time.Hour), WorkflowName: "CI", Labels: []string{"gpu"}},
+		},
+		{
+			name:       "smallest label threshold wins",
+			job:        &entity.Job{ID: 5, Status: "in_progress", StartedAt: ago(20 * time.Minute), WorkflowName: "CI", Labels: []string{"gpu", "short"}},
+			wantReason: value_object.FlagLongRunning,
+			wantLimit:  10 * time.Minute,
+		},
+		{
+			name:       "queued longer than threshold",
+			job:        &entity.Job{ID: 6, Status: "queued", CreatedAt: ago(15 * time.Minute)},
+			wantReason: value_object.FlagLongQueued,
+			wantLimit:  10 * time.Minute,
+		},
+		{
+			name: "queued without creation time",
+			job:  &entity.Job{ID: 7, Status: "queued"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagged := DetectStuckJobs([]*entity.Job{tt.job}, now, thresholds)
+
+			if tt.wantReason == "" {
+				if len(flagged) != 0 {
+					t.Errorf("expected job not to be flagged, got %s", flagged[0].Reason)
+				}
+				return
+			}
+
+			if len(flagged) != 1 {
+				t.Fatalf("expected 1 flagged job, got %d", len(flagged))
+			}
+			if flagged[0].Reason != tt.wantReason {
+				t.Errorf("expected reason %s, got %s", tt.wantReason, flagged[0].Reason)
+			}
+			if flagged[0].Threshold != tt.wantLimit {
+				t.Errorf("expected threshold %s, got %s", tt.wantLimit, flagged[0].Threshold)
+			}
+		})
+	}
+
+	t.Run("zero thresholds disable detection", func(t *testing.T) {
+		jobs := []*entity.Job{
+			{ID: 1, Status: "in_progress", StartedAt: ago(24 * time.Hour)},
+			{ID: 2, Status: "queued", CreatedAt: ago(24 * time.Hour)},
+		}
+
+		if flagged := DetectStuckJobs(jobs, now, value_object.JobThresholds{}); len(flagged) != 0 {
+			t.Errorf("expected no flagged jobs, got %d", len(flagged))
+		}
+	})
+}
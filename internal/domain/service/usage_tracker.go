@@ -0,0 +1,175 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// workflowKey identifies a workflow across repositories
+type workflowKey struct {
+	repository string
+	workflow   string
+}
+
+// jobSpan is the part of a job's run that was observed, from the later of its start and the first snapshot
+// to the last snapshot it was running in
+type jobSpan struct {
+	key  workflowKey
+	from time.Time
+	to   time.Time
+}
+
+// UsageTracker sums the self-hosted runner time used by every workflow across successive snapshots
+// The observed span of every running job is kept, so that a replay stepped back shows the usage up to that point
+// in time; the spans of finished jobs are folded into per-workflow totals once the snapshots have passed their end
+type UsageTracker struct {
+	spans map[int64]*jobSpan
+	// finished holds the runner time of the finished jobs of every workflow
+	finished map[workflowKey]time.Duration
+	// counted is the furthest snapshot time seen, time up to it was already counted
+	counted time.Time
+}
+
+// NewUsageTracker creates a new UsageTracker without history
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{
+		spans:    make(map[int64]*jobSpan),
+		finished: make(map[workflowKey]time.Duration),
+	}
+}
+
+// Track adds the runner time used since the previous snapshot and returns the usage by repository and workflow
+// Only jobs running on a runner of the snapshot are counted, which leaves out GitHub-hosted runners
+// A running job is counted from the previous snapshot or from its start, whichever is later, and snapshots
+// before the furthest one seen show the time counted up to them without counting it again, except for
+// finished jobs, whose whole time is shown
+// Repositories are sorted by runner time, then by the number of runners they occupy, the heaviest first
+func (t *UsageTracker) Track(data *value_object.MonitorData) []*value_object.RepositoryUsage {
+	usages := make(map[workflowKey]*value_object.WorkflowUsage)
+	usageFor := func(key workflowKey) *value_object.WorkflowUsage {
+		usage, ok := usages[key]
+		if !ok {
+			usage = &value_object.WorkflowUsage{Repository: key.repository, WorkflowName: key.workflow}
+			usages[key] = usage
+		}
+		return usage
+	}
+
+	index := data.JobIndex
+	if index == nil {
+		index = value_object.NewJobIndex(data.Runners, data.Jobs)
+	}
+	selfHosted := make(map[int64]bool, len(data.Runners))
+	for _, runner := range data.Runners {
+		if job := index.ByRunnerID(runner.ID); job != nil {
+			selfHosted[job.ID] = true
+		}
+	}
+
+	forward := t.counted.IsZero() || data.CurrentTime.After(t.counted)
+	for _, job := range data.Jobs {
+		key := workflowKey{repository: job.Repository, workflow: job.WorkflowName}
+		switch {
+		case job.IsRunning() && selfHosted[job.ID]:
+			usageFor(key).Running++
+			if forward {
+				t.extendSpan(job, key, data.CurrentTime)
+			}
+		case job.IsQueued():
+			usageFor(key).Queued++
+		}
+	}
+	if forward {
+		t.counted = data.CurrentTime
+	}
+	t.foldFinishedSpans(data.CurrentTime)
+
+	for key, runnerTime := range t.finished {
+		usageFor(key).RunnerTime += runnerTime
+	}
+	for _, span := range t.spans {
+		if to := minTime(span.to, data.CurrentTime); to.After(span.from) {
+			usageFor(span.key).RunnerTime += to.Sub(span.from)
+		}
+	}
+
+	repositories := make(map[string]*value_object.RepositoryUsage)
+	for _, usage := range usages {
+		repository, ok := repositories[usage.Repository]
+		if !ok {
+			repository = &value_object.RepositoryUsage{Repository: usage.Repository}
+			repositories[usage.Repository] = repository
+		}
+		repository.Running += usage.Running
+		repository.Queued += usage.Queued
+		repository.RunnerTime += usage.RunnerTime
+		repository.Workflows = append(repository.Workflows, usage)
+	}
+
+	result := make([]*value_object.RepositoryUsage, 0, len(repositories))
+	for _, repository := range repositories {
+		slices.SortFunc(repository.Workflows, func(a, b *value_object.WorkflowUsage) int {
+			return compareUsage(a.RunnerTime, b.RunnerTime, a.Running, b.Running, a.WorkflowName, b.WorkflowName)
+		})
+		result = append(result, repository)
+	}
+	slices.SortFunc(result, func(a, b *value_object.RepositoryUsage) int {
+		return compareUsage(a.RunnerTime, b.RunnerTime, a.Running, b.Running, a.Repository, b.Repository)
+	})
+	return result
+}
+
+// extendSpan records that the job was running up to now
+// A job seen for the first time is counted from the previous snapshot or from its start, whichever is later
+func (t *UsageTracker) extendSpan(job *entity.Job, key workflowKey, now time.Time) {
+	if span, ok := t.spans[job.ID]; ok {
+		span.to = now
+		return
+	}
+
+	from := t.counted
+	if from.IsZero() {
+		from = now
+	}
+	if job.StartedAt != nil && job.StartedAt.After(from) {
+		from = *job.StartedAt
+	}
+	t.spans[job.ID] = &jobSpan{key: key, from: from, to: now}
+}
+
+// foldFinishedSpans adds the spans that ended before now to the totals of their workflows and forgets them
+// A span ends before now when its job was not running in the snapshot, a job running again starts a new span
+func (t *UsageTracker) foldFinishedSpans(now time.Time) {
+	for id, span := range t.spans {
+		if !span.to.Before(now) {
+			continue
+		}
+		if span.to.After(span.from) {
+			t.finished[span.key] += span.to.Sub(span.from)
+		}
+		delete(t.spans, id)
+	}
+}
+
+// minTime returns the earlier of the two times
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// compareUsage orders by runner time and running jobs, the heaviest first, then by name
+func compareUsage(timeA, timeB time.Duration, runningA, runningB int, nameA, nameB string) int {
+	if c := cmp.Compare(timeB, timeA); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(runningB, runningA); c != 0 {
+		return c
+	}
+	return cmp.Compare(nameA, nameB)
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestUsageTracker(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := start.Add(d)
+		return &t
+	}
+	runnerID := func(id int64) *int64 {
+		return &id
+	}
+	// Every running job gets a runner of the snapshot, except on the hosted runner 99
+	snapshot := func(now time.Duration, jobs ...*entity.Job) *value_object.MonitorData {
+		data := &value_object.MonitorData{CurrentTime: start.Add(now), Jobs: jobs}
+		for _, job := range jobs {
+			if job.RunnerID != nil && *job.RunnerID != 99 {
+				data.Runners = append(data.Runners, &entity.Runner{ID: *job.RunnerID, Status: entity.StatusActive})
+			}
+		}
+		return data
+	}
+
+	build := &entity.Job{ID: 1, Repository: "org/app", WorkflowName: "CI", Status: "in_progress", StartedAt: at(-time.Hour), RunnerID: runnerID(1)}
+	test := &entity.Job{ID: 2, Repository: "org/app", WorkflowName: "CI", Status: "in_progress", StartedAt: at(2 * time.Minute), RunnerID: runnerID(2)}
+	deploy := &entity.Job{ID: 3, Repository: "org/app", WorkflowName: "Deploy", Status: "queued"}
+	docs := &entity.Job{ID: 4, Repository: "org/docs", WorkflowName: "Pages", Status: "in_progress", StartedAt: at(0), RunnerID: runnerID(3)}
+	hosted := &entity.Job{ID: 5, Repository: "org/app", WorkflowName: "Lint", Status: "in_progress", StartedAt: at(0), RunnerID: runnerID(99)}
+
+	t.Run("sums runner time from the start of the session", func(t *testing.T) {
+		tracker := NewUsageTracker()
+		tracker.Track(snapshot(0, build, docs))
+		tracker.Track(snapshot(5*time.Minute, build, test, docs))
+
+		usages := tracker.Track(snapshot(10*time.Minute, build, test, deploy))
+
+		if len(usages) != 2 {
+			t.Fatalf("expected 2 repositories, got %d", len(usages))
+		}
+
+		app := usages[0]
+		if app.Repository != "org/app" || app.Running != 2 || app.Queued != 1 {
+			t.Errorf("expected org/app with 2 running and 1 queued, got %s with %d and %d", app.Repository, app.Running, app.Queued)
+		}
+		// build ran 10m within the session, test 8m since its start
+		if app.RunnerTime != 18*time.Minute {
+			t.Errorf("expected 18m of runner time for org/app, got %s", app.RunnerTime)
+		}
+		if len(app.Workflows) != 2 || app.Workflows[0].WorkflowName != "CI" || app.Workflows[1].WorkflowName != "Deploy" {
+			t.Fatalf("expected workflows CI and Deploy, got %v", app.Workflows)
+		}
+		if app.Workflows[1].Queued != 1 {
+			t.Errorf("expected 1 queued Deploy job, got %d", app.Workflows[1].Queued)
+		}
+
+		docsUsage := usages[1]
+		if docsUsage.Repository != "org/docs" || docsUsage.Running != 0 || docsUsage.RunnerTime != 5*time.Minute {
+			t.Errorf("expected org/docs to keep 5m of runner time without running jobs, got %s with %d and %s",
+				docsUsage.Repository, docsUsage.Running, docsUsage.RunnerTime)
+		}
+	})
+
+	t.Run("stepping back shows the time up to that point without counting it again", func(t *testing.T) {
+		tracker := NewUsageTracker()
+		tracker.Track(snapshot(0, build))
+		tracker.Track(snapshot(5*time.Minute, build))
+
+		tracker.Track(snapshot(time.Minute, build))
+		usages := tracker.Track(snapshot(2*time.Minute, build))
+		if usages[0].RunnerTime != 2*time.Minute {
+			t.Errorf("expected 2m of runner time, got %s", usages[0].RunnerTime)
+		}
+
+		usages = tracker.Track(snapshot(6*time.Minute, build))
+		if usages[0].RunnerTime != 6*time.Minute {
+			t.Errorf("expected 6m of runner time, got %s", usages[0].RunnerTime)
+		}
+	})
+
+	t.Run("keeps only the spans of running jobs", func(t *testing.T) {
+		tracker := NewUsageTracker()
+		tracker.Track(snapshot(0, build, docs))
+		tracker.Track(snapshot(5*time.Minute, build, docs))
+
+		usages := tracker.Track(snapshot(10*time.Minute, build))
+
+		if len(tracker.spans) != 1 || tracker.spans[build.ID] == nil {
+			t.Errorf("expected only the span of the running build job, got %d spans", len(tracker.spans))
+		}
+		if len(usages) != 2 || usages[1].Repository != "org/docs" || usages[1].RunnerTime != 5*time.Minute {
+			t.Errorf("expected org/docs to keep the 5m of its finished job, got %v", usages)
+		}
+
+		usages = tracker.Track(snapshot(15*time.Minute, build))
+		if usages[1].RunnerTime != 5*time.Minute {
+			t.Errorf("expected the finished job to be counted once, got %s", usages[1].RunnerTime)
+		}
+	})
+
+	t.Run("jobs on GitHub-hosted runners are not counted", func(t *testing.T) {
+		tracker := NewUsageTracker()
+		tracker.Track(snapshot(0, hosted))
+
+		usages := tracker.Track(snapshot(5*time.Minute, hosted))
+
+		if len(usages) != 0 {
+			t.Errorf("expected no usage, got %v", usages)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package value_object
+
+import (
+	"fmt"
+	"time"
+)
+
+// AlertCondition identifies what an alert rule checks
+type AlertCondition string
+
+const (
+	// ConditionRunnerCount compares the number of matching runners with a value
+	ConditionRunnerCount AlertCondition = "runner_count"
+	// ConditionJobDuration fires when a matching job runs longer than a duration
+	ConditionJobDuration AlertCondition = "job_duration"
+	// ConditionQueueWait fires when a matching job waits in the queue longer than a duration
+	ConditionQueueWait AlertCondition = "queue_wait"
+	// ConditionRunnerOffline fires when a matching runner stays offline longer than a duration
+	ConditionRunnerOffline AlertCondition = "runner_offline"
+)
+
+// StatusOnline matches runners that are idle, active or busy in alert rules
+const StatusOnline = "Online"
+
+// AlertRule is a declarative condition evaluated against each snapshot
+type AlertRule struct {
+	Name      string
+	Condition AlertCondition
+	// Label restricts the rule to runners or jobs with this label
+	Label string
+	// Workflow restricts job conditions to jobs of this workflow
+	Workflow string
+	// Status restricts runner_count to runners with this status (Idle, Active, Offline or Online)
+	Status string
+	// Operator and Value are used by runner_count (<, <=, ==, !=, >=, >)
+	Operator string
+	Value    int
+	// Duration is used by job_duration, queue_wait and runner_offline
+	Duration time.Duration
+}
+
+// AlertState is the state of an alert rule
+type AlertState string
+
+const (
+	AlertFiring   AlertState = "firing"
+	AlertResolved AlertState = "resolved"
+)
+
+// AlertEvent is emitted when an alert rule starts or stops firing
+type AlertEvent struct {
+	Rule    AlertRule
+	State   AlertState
+	Message string
+	// Subjects names the runners or jobs that triggered the rule
+	Subjects []string
+	At       time.Time
+}
+
+// Validate checks that the rule has the fields its condition requires
+func (r AlertRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("alert rule name is required")
+	}
+	switch r.Condition {
+	case ConditionRunnerCount:
+		switch r.Operator {
+		case "<", "<=", "==", "!=", ">=", ">":
+		default:
+			return fmt.Errorf("alert rule %q: invalid operator %q", r.Name, r.Operator)
+		}
+	case ConditionJobDuration, ConditionQueueWait, ConditionRunnerOffline:
+		if r.Duration <= 0 {
+			return fmt.Errorf("alert rule %q: duration must be positive", r.Name)
+		}
+	default:
+		return fmt.Errorf("alert rule %q: unknown condition %q", r.Name, r.Condition)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package value_object
+
+import "time"
+
+// EventType identifies a transition between two snapshots
+type EventType string
+
+const (
+	EventRunnerAdded         EventType = "runner_added"
+	EventRunnerRemoved       EventType = "runner_removed"
+	EventRunnerOffline       EventType = "runner_offline"
+	EventRunnerOnline        EventType = "runner_online"
+	EventRunnerStatusChanged EventType = "runner_status_changed"
+	EventRunnerLabelsChanged EventType = "runner_labels_changed"
+	EventJobQueued           EventType = "job_queued"
+	EventJobStarted          EventType = "job_started"
+	EventJobFinished         EventType = "job_finished"
+	// EventAction records an action requested from the monitor, such as cancelling a run
+	EventAction EventType = "action"
+)
+
+// AllEventTypes lists every event type in display order
+var AllEventTypes = []EventType{
+	EventRunnerAdded,
+	EventRunnerRemoved,
+	EventRunnerOffline,
+	EventRunnerOnline,
+	EventRunnerStatusChanged,
+	EventRunnerLabelsChanged,
+	EventJobQueued,
+	EventJobStarted,
+	EventJobFinished,
+	EventAction,
+}
+
+// Event describes a transition detected between two consecutive snapshots
+type Event struct {
+	Type       EventType
+	At         time.Time
+	RunnerName string
+	JobName    string
+	Message    string
+}
@@ -0,0 +1,23 @@
+package value_object
+
+import (
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+// FlagReason describes why a job was flagged
+type FlagReason string
+
+const (
+	FlagLongRunning FlagReason = "LongRunning"
+	FlagLongQueued  FlagReason = "LongQueued"
+)
+
+// FlaggedJob is a job that exceeded one of the configured thresholds
+type FlaggedJob struct {
+	Job       *entity.Job
+	Reason    FlagReason
+	Duration  time.Duration
+	Threshold time.Duration
+}
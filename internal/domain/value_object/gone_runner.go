@@ -0,0 +1,43 @@
+package value_object
+
+import (
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+// DefaultGoneRetention is how long runners that disappeared are kept by default
+const DefaultGoneRetention = 10 * time.Minute
+
+// GoneRunner is a runner that disappeared between snapshots, such as an ephemeral runner after its job
+type GoneRunner struct {
+	Runner *entity.Runner
+	// LastJob is the last job seen on the runner, nil if it never had one
+	LastJob   *entity.Job
+	FirstSeen time.Time
+	GoneAt    time.Time
+	// SeenAtStart is set when the runner was already registered in the first snapshot, so that it started
+	// before FirstSeen and Lifetime is only a lower bound
+	SeenAtStart bool
+}
+
+// Lifetime returns how long the runner was seen before it disappeared
+func (g *GoneRunner) Lifetime() time.Duration {
+	return g.GoneAt.Sub(g.FirstSeen)
+}
+
+// LabelChurn is the number of runners with a label that disappeared recently
+type LabelChurn struct {
+	Label string
+	Count int
+	// Window is the time the runners were counted over, shorter than the retention time until the monitor ran that long
+	Window time.Duration
+}
+
+// PerHour returns the hourly rate of runners that disappeared over the window
+func (c *LabelChurn) PerHour() float64 {
+	if c.Window <= 0 {
+		return 0
+	}
+	return float64(c.Count) / c.Window.Hours()
+}
@@ -0,0 +1,92 @@
+package value_object
+
+import "github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+
+// JobIndex looks up the job assigned to a runner without scanning every job
+// It is built once per snapshot
+type JobIndex struct {
+	byRunnerID   map[int64]*entity.Job
+	byRunnerName map[string]*entity.Job
+}
+
+// NewJobIndex indexes the jobs by the runner they are assigned to
+// Jobs are matched by runner ID, or by runner name when the API did not report a usable ID
+// Jobs are also kept by the runner name they report, to look up the job of a runner missing from the list
+// When several jobs are assigned to one runner, a running job wins over the others, then the first one
+func NewJobIndex(runners []*entity.Runner, jobs []*entity.Job) *JobIndex {
+	index := &JobIndex{
+		byRunnerID:   make(map[int64]*entity.Job, len(jobs)),
+		byRunnerName: make(map[string]*entity.Job, len(jobs)),
+	}
+
+	runnersByName := make(map[string][]*entity.Runner, len(runners))
+	for _, runner := range runners {
+		runnersByName[runner.Name] = append(runnersByName[runner.Name], runner)
+	}
+
+	for _, job := range jobs {
+		if job.HasRunnerName() {
+			addJob(index.byRunnerName, *job.RunnerName, job)
+		}
+
+		if job.HasRunnerID() {
+			addJob(index.byRunnerID, *job.RunnerID, job)
+			continue
+		}
+		if !job.HasRunnerName() {
+			continue
+		}
+		if runner := resolveRunnerByName(runnersByName[*job.RunnerName]); runner != nil {
+			addJob(index.byRunnerID, runner.ID, job)
+		}
+	}
+	return index
+}
+
+// ByRunnerID returns the job assigned to the runner with the ID, or nil if there is none
+func (i *JobIndex) ByRunnerID(runnerID int64) *entity.Job {
+	if i == nil {
+		return nil
+	}
+	return i.byRunnerID[runnerID]
+}
+
+// ByRunnerName returns the job reported with the runner name, or nil if there is none
+// Unlike ByRunnerID, the runner does not have to be listed, e.g. an ephemeral runner removed while its job finishes
+func (i *JobIndex) ByRunnerName(name string) *entity.Job {
+	if i == nil {
+		return nil
+	}
+	return i.byRunnerName[name]
+}
+
+// addJob stores the job under the key unless a preferred job is already stored
+func addJob[K comparable](jobs map[K]*entity.Job, key K, job *entity.Job) {
+	if current, ok := jobs[key]; !ok || preferJob(job, current) {
+		jobs[key] = job
+	}
+}
+
+// preferJob returns true if job should replace current as the job of their runner
+func preferJob(job, current *entity.Job) bool {
+	return job.IsRunning() && !current.IsRunning()
+}
+
+// resolveRunnerByName picks the runner a job reported by name only belongs to
+// Ephemeral runners reuse names, so an online runner wins over an offline one, then the most recently registered one
+func resolveRunnerByName(candidates []*entity.Runner) *entity.Runner {
+	var resolved *entity.Runner
+	for _, runner := range candidates {
+		switch {
+		case resolved == nil:
+			resolved = runner
+		case runner.IsOnline() != resolved.IsOnline():
+			if runner.IsOnline() {
+				resolved = runner
+			}
+		case runner.ID > resolved.ID:
+			resolved = runner
+		}
+	}
+	return resolved
+}
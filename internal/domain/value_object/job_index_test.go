@@ -0,0 +1,176 @@
+package value_object
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+func TestJobIndex_ByRunnerID(t *testing.T) {
+	id := func(v int64) *int64 {
+		return &v
+	}
+	name := func(v string) *string {
+		return &v
+	}
+
+	tests := []struct {
+		name     string
+		runners  []*entity.Runner
+		jobs     []*entity.Job
+		runnerID int64
+		expected int64 // ID of the expected job, 0 for none
+	}{
+		{
+			name:     "matches the runner ID",
+			runners:  []*entity.Runner{{ID: 1, Name: "runner-1"}},
+			jobs:     []*entity.Job{{ID: 10, RunnerID: id(1)}},
+			runnerID: 1,
+			expected: 10,
+		},
+		{
+			name:     "runner ID wins over a matching name",
+			runners:  []*entity.Runner{{ID: 1, Name: "runner-1"}, {ID: 2, Name: "runner-2"}},
+			jobs:     []*entity.Job{{ID: 10, RunnerID: id(2), RunnerName: name("runner-1")}},
+			runnerID: 1,
+		},
+		{
+			name:     "falls back to the name without a runner ID",
+			runners:  []*entity.Runner{{ID: 1, Name: "runner-1"}},
+			jobs:     []*entity.Job{{ID: 10, RunnerName: name("runner-1")}},
+			runnerID: 1,
+			expected: 10,
+		},
+		{
+			name:     "falls back to the name with a zero runner ID",
+			runners:  []*entity.Runner{{ID: 1, Name: "runner-1"}},
+			jobs:     []*entity.Job{{ID: 10, RunnerID: id(0), RunnerName: name("runner-1")}},
+			runnerID: 1,
+			expected: 10,
+		},
+		{
+			name: "a reused name resolves to the online runner",
+			runners: []*entity.Runner{
+				{ID: 1, Name: "ephemeral", Status: entity.StatusIdle},
+				{ID: 2, Name: "ephemeral", Status: entity.StatusOffline},
+			},
+			jobs:     []*entity.Job{{ID: 10, RunnerName: name("ephemeral")}},
+			runnerID: 1,
+			expected: 10,
+		},
+		{
+			name: "a reused name resolves to the newest runner when both are online",
+			runners: []*entity.Runner{
+				{ID: 1, Name: "ephemeral", Status: entity.StatusIdle},
+				{ID: 2, Name: "ephemeral", Status: entity.StatusIdle},
+			},
+			jobs:     []*entity.Job{{ID: 10, RunnerName: name("ephemeral")}},
+			runnerID: 2,
+			expected: 10,
+		},
+		{
+			name:    "a running job wins over a queued one",
+			runners: []*entity.Runner{{ID: 1, Name: "runner-1"}},
+			jobs: []*entity.Job{
+				{ID: 10, Status: "queued", RunnerID: id(1)},
+				{ID: 11, Status: "in_progress", RunnerID: id(1)},
+				{ID: 12, Status: "queued", RunnerID: id(1)},
+			},
+			runnerID: 1,
+			expected: 11,
+		},
+		{
+			name:     "unknown names match no runner",
+			runners:  []*entity.Runner{{ID: 1, Name: "runner-1"}},
+			jobs:     []*entity.Job{{ID: 10, RunnerName: name("runner-2")}, {ID: 11}},
+			runnerID: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := NewJobIndex(tt.runners, tt.jobs).ByRunnerID(tt.runnerID)
+
+			switch {
+			case tt.expected == 0 && job != nil:
+				t.Errorf("expected no job, got %d", job.ID)
+			case tt.expected != 0 && (job == nil || job.ID != tt.expected):
+				t.Errorf("expected job %d, got %v", tt.expected, job)
+			}
+		})
+	}
+
+	t.Run("a nil index has no jobs", func(t *testing.T) {
+		var index *JobIndex
+		if job := index.ByRunnerID(1); job != nil {
+			t.Errorf("expected no job, got %d", job.ID)
+		}
+	})
+}
+
+func TestJobIndex_ByRunnerName(t *testing.T) {
+	id := int64(1)
+	name := "ephemeral"
+	runners := []*entity.Runner{{ID: 2, Name: "runner-2"}}
+	jobs := []*entity.Job{
+		{ID: 10, Status: "queued", RunnerName: &name},
+		{ID: 11, Status: "in_progress", RunnerID: &id, RunnerName: &name},
+	}
+
+	index := NewJobIndex(runners, jobs)
+
+	if job := index.ByRunnerName(name); job == nil || job.ID != 11 {
+		t.Errorf("expected the running job 11 of the unlisted runner, got %v", job)
+	}
+	if job := index.ByRunnerName("runner-2"); job != nil {
+		t.Errorf("expected no job, got %d", job.ID)
+	}
+
+	var empty *JobIndex
+	if job := empty.ByRunnerName(name); job != nil {
+		t.Errorf("expected no job, got %d", job.ID)
+	}
+}
+
+// BenchmarkNewJobIndex compares looking up the job of every runner through the index, including building it,
+// with scanning every job for every runner
+func BenchmarkNewJobIndex(b *testing.B) {
+	const runnerCount, jobCount = 5000, 2000
+	runners := make([]*entity.Runner, 0, runnerCount)
+	for i := range runnerCount {
+		runners = append(runners, &entity.Runner{ID: int64(i + 1), Name: fmt.Sprintf("runner-%d", i+1), Status: entity.StatusIdle})
+	}
+	jobs := make([]*entity.Job, 0, jobCount)
+	for i := range jobCount {
+		runner := runners[i*runnerCount/jobCount]
+		job := &entity.Job{ID: int64(i + 1), Status: "in_progress", RunnerName: &runner.Name}
+		// Every other job is reported by name only
+		if i%2 == 0 {
+			job.RunnerID = &runner.ID
+		}
+		jobs = append(jobs, job)
+	}
+
+	b.Run("index", func(b *testing.B) {
+		for b.Loop() {
+			index := NewJobIndex(runners, jobs)
+			for _, runner := range runners {
+				_ = index.ByRunnerID(runner.ID)
+			}
+		}
+	})
+
+	b.Run("nested scan", func(b *testing.B) {
+		for b.Loop() {
+			for _, runner := range runners {
+				for _, job := range jobs {
+					if job.HasRunnerID() && *job.RunnerID == runner.ID ||
+						!job.HasRunnerID() && job.HasRunnerName() && *job.RunnerName == runner.Name {
+						break
+					}
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package value_object
+
+import "time"
+
+// Default thresholds used when no configuration is given
+const (
+	DefaultLongRunningThreshold = 1 * time.Hour
+	DefaultLongQueuedThreshold  = 15 * time.Minute
+)
+
+// JobThresholds holds the durations after which a job is considered stuck
+// A zero duration disables the corresponding check
+type JobThresholds struct {
+	// LongRunning applies to running jobs without a more specific threshold
+	LongRunning time.Duration
+	// LongRunningByWorkflow overrides LongRunning for jobs of a workflow
+	LongRunningByWorkflow map[string]time.Duration
+	// LongRunningByLabel overrides LongRunning for jobs requesting a label
+	LongRunningByLabel map[string]time.Duration
+	// LongQueued applies to jobs waiting for a runner
+	LongQueued time.Duration
+}
+
+// DefaultJobThresholds returns the thresholds used when nothing is configured
+func DefaultJobThresholds() JobThresholds {
+	return JobThresholds{
+		LongRunning: DefaultLongRunningThreshold,
+		LongQueued:  DefaultLongQueuedThreshold,
+	}
+}
@@ -0,0 +1,22 @@
+package value_object
+
+import "time"
+
+// LabelCapacity summarizes the runners carrying a label and the queued jobs requesting it
+type LabelCapacity struct {
+	Label   string
+	Total   int
+	Idle    int
+	Active  int
+	Offline int
+	// QueuedJobs is the number of queued jobs requesting the label
+	QueuedJobs int
+	// LongestQueueWait is how long the oldest of those jobs has been waiting
+	LongestQueueWait time.Duration
+}
+
+// Pressure returns the number of queued jobs that cannot start on an idle runner right away
+// A negative pressure is spare capacity
+func (c *LabelCapacity) Pressure() int {
+	return c.QueuedJobs - c.Idle
+}
@@ -11,4 +11,27 @@ type MonitorData struct {
 	CurrentTime time.Time
 	Runners     []*entity.Runner
 	Jobs        []*entity.Job
+	// ReportedStatuses holds the status of each runner by ID as the repository reported it
+	// Runners hold the status derived from the jobs instead, e.g. Active for an idle runner with a running job
+	ReportedStatuses map[int64]entity.RunnerStatus
+	// JobsIncomplete is set when the jobs of some workflow runs could not be fetched, Warnings holds the reasons
+	// Jobs missing from such a snapshot may still be active
+	JobsIncomplete bool
+	// JobIndex looks up the job of each runner
+	JobIndex    *JobIndex
+	FlaggedJobs []*FlaggedJob
+	// Mismatches holds the runners whose reported status disagrees with the job data
+	Mismatches []*StatusMismatch
+	// GoneRunners holds the runners that disappeared recently, most recent first
+	GoneRunners []*GoneRunner
+	// Churn counts the recently disappeared runners per label
+	Churn []*LabelChurn
+	// QueueForecasts estimates the wait of every queued job, the longest waiting job first
+	QueueForecasts []*QueueForecast
+	// Usage breaks the fleet usage down by repository and workflow, the heaviest first
+	Usage []*RepositoryUsage
+	// Events holds the transitions since the previous snapshot
+	Events []*Event
+	// Warnings holds non-fatal errors raised while processing the snapshot
+	Warnings []error
 }
@@ -0,0 +1,30 @@
+package value_object
+
+import (
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+// ForecastConfidence describes how much observed history a queue wait estimate is based on
+type ForecastConfidence string
+
+const (
+	// ConfidenceNone means there is no history to estimate from
+	ConfidenceNone   ForecastConfidence = "None"
+	ConfidenceLow    ForecastConfidence = "Low"
+	ConfidenceMedium ForecastConfidence = "Medium"
+	ConfidenceHigh   ForecastConfidence = "High"
+)
+
+// QueueForecast estimates how long a queued job waits until it starts
+type QueueForecast struct {
+	Job *entity.Job
+	// Position is the number of queued jobs with the same labels ahead of the job
+	Position int
+	// EstimatedWait is the time from now until the job is expected to start, unset for ConfidenceNone
+	EstimatedWait time.Duration
+	Confidence    ForecastConfidence
+	// Samples is the number of recently completed jobs the estimate is based on
+	Samples int
+}
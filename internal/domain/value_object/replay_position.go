@@ -0,0 +1,17 @@
+package value_object
+
+import "time"
+
+// ReplayPosition describes where playback of a recorded timeline currently is
+type ReplayPosition struct {
+	// Index is the zero-based index of the current snapshot
+	Index int
+	// Count is the number of snapshots in the timeline
+	Count int
+	// Time is the recorded time of the current snapshot
+	Time time.Time
+	// Paused is true when the timeline does not advance on its own
+	Paused bool
+	// Speed is the playback speed relative to the recording, zero advances one snapshot per refresh
+	Speed float64
+}
@@ -0,0 +1,25 @@
+package value_object
+
+import "time"
+
+// WorkflowUsage describes how much of the fleet the jobs of a workflow use
+type WorkflowUsage struct {
+	Repository   string
+	WorkflowName string
+	// Running is the number of runners the workflow occupies now
+	Running int
+	// Queued is the number of jobs of the workflow waiting for a runner
+	Queued int
+	// RunnerTime is the runner time the workflow used while it was monitored
+	RunnerTime time.Duration
+}
+
+// RepositoryUsage sums the usage of the workflows of a repository
+type RepositoryUsage struct {
+	Repository string
+	Running    int
+	Queued     int
+	RunnerTime time.Duration
+	// Workflows are sorted by runner time, the heaviest first
+	Workflows []*WorkflowUsage
+}
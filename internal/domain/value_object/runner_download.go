@@ -0,0 +1,10 @@
+package value_object
+
+// RunnerDownload describes a runner application package advertised by GitHub
+type RunnerDownload struct {
+	OS             string
+	Architecture   string
+	DownloadURL    string
+	Filename       string
+	SHA256Checksum string
+}
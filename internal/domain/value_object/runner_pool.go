@@ -0,0 +1,45 @@
+package value_object
+
+import "github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+
+// PoolGrouping selects how runners are grouped into pools
+type PoolGrouping string
+
+const (
+	// PoolGroupingNone shows every runner on its own
+	PoolGroupingNone PoolGrouping = ""
+	// PoolGroupingPrefix groups runners by scale set or name prefix
+	PoolGroupingPrefix PoolGrouping = "prefix"
+	// PoolGroupingLabels groups runners with the same set of labels
+	PoolGroupingLabels PoolGrouping = "labels"
+)
+
+// PoolGroupings lists the groupings in the order they are cycled through
+var PoolGroupings = []PoolGrouping{PoolGroupingPrefix, PoolGroupingLabels}
+
+// RunnerPool aggregates runners that serve the same jobs, such as the ephemeral runners of a scale set
+type RunnerPool struct {
+	Name    string
+	Labels  []string
+	Runners []*entity.Runner
+	// RunningJobs are the jobs running on the runners of the pool
+	RunningJobs []*entity.Job
+	// QueuedJobs are the queued jobs a runner of the pool can pick up
+	// A job several pools can serve is counted only for the one it is most likely to run on
+	QueuedJobs []*entity.Job
+	// Current is the number of online runners
+	Current int
+	// Busy is the number of runners executing a job
+	Busy    int
+	Offline int
+}
+
+// Desired returns the number of runners needed for the busy and queued jobs, like the desired replicas of a scale set
+func (p *RunnerPool) Desired() int {
+	return p.Busy + len(p.QueuedJobs)
+}
+
+// Idle returns the number of online runners without a job
+func (p *RunnerPool) Idle() int {
+	return p.Current - p.Busy
+}
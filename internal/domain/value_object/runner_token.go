@@ -0,0 +1,69 @@
+package value_object
+
+import (
+	"fmt"
+	"time"
+)
+
+// TokenKind identifies the purpose of a runner token
+type TokenKind string
+
+const (
+	// TokenRegistration is used to register a new self-hosted runner
+	TokenRegistration TokenKind = "registration"
+	// TokenRemove is used to remove a self-hosted runner
+	TokenRemove TokenKind = "remove"
+)
+
+// RunnerScope identifies the repository, organization or enterprise that runners belong to
+type RunnerScope struct {
+	Owner      string
+	Repo       string
+	Org        string
+	Enterprise string
+}
+
+// Validate checks that exactly one scope is set
+func (s RunnerScope) Validate() error {
+	count := 0
+	if s.Owner != "" || s.Repo != "" {
+		if s.Owner == "" || s.Repo == "" {
+			return fmt.Errorf("repository scope requires both owner and repo")
+		}
+		count++
+	}
+	if s.Org != "" {
+		count++
+	}
+	if s.Enterprise != "" {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of repository, organization or enterprise must be specified")
+	}
+	return nil
+}
+
+// IsRepository returns true if the scope is a single repository
+func (s RunnerScope) IsRepository() bool {
+	return s.Org == "" && s.Enterprise == ""
+}
+
+// String returns a human readable description of the scope
+func (s RunnerScope) String() string {
+	switch {
+	case s.Enterprise != "":
+		return fmt.Sprintf("enterprise %s", s.Enterprise)
+	case s.Org != "":
+		return fmt.Sprintf("organization %s", s.Org)
+	default:
+		return fmt.Sprintf("repository %s/%s", s.Owner, s.Repo)
+	}
+}
+
+// RunnerToken is a short-lived token for registering or removing runners
+type RunnerToken struct {
+	Kind      TokenKind
+	Token     string
+	ExpiresAt time.Time
+}
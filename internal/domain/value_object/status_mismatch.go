@@ -0,0 +1,23 @@
+package value_object
+
+import "github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+
+// MismatchReason describes how the runner API and the job data disagree
+type MismatchReason string
+
+const (
+	// MismatchBusyWithoutJob is a runner reported busy without a visible running job
+	MismatchBusyWithoutJob MismatchReason = "BusyWithoutJob"
+	// MismatchIdleWithJob is a runner reported idle while a job is running on it
+	MismatchIdleWithJob MismatchReason = "IdleWithJob"
+	// MismatchOfflineWithJob is a runner reported offline while a job is running on it
+	MismatchOfflineWithJob MismatchReason = "OfflineWithJob"
+)
+
+// StatusMismatch is a runner whose reported status disagrees with the job data
+type StatusMismatch struct {
+	Runner *entity.Runner
+	// Job is the running job of the runner, nil for MismatchBusyWithoutJob
+	Job    *entity.Job
+	Reason MismatchReason
+}
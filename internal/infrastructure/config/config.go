@@ -0,0 +1,178 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// Config represents the structure of the JSON configuration file
+type Config struct {
+	Thresholds ThresholdsConfig `json:"thresholds"`
+	Alerts     AlertsConfig     `json:"alerts"`
+}
+
+// ThresholdsConfig holds the stuck job thresholds
+// Omitted global thresholds fall back to the defaults
+type ThresholdsConfig struct {
+	LongRunning *Duration           `json:"long_running"`
+	LongQueued  *Duration           `json:"long_queued"`
+	Workflows   map[string]Duration `json:"workflows"`
+	Labels      map[string]Duration `json:"labels"`
+}
+
+// AlertsConfig holds the alert rules and where their events are sent
+type AlertsConfig struct {
+	Rules     []AlertRuleConfig `json:"rules"`
+	Notifiers []NotifierConfig  `json:"notifiers"`
+}
+
+// AlertRuleConfig is the JSON representation of an alert rule
+type AlertRuleConfig struct {
+	Name      string   `json:"name"`
+	Condition string   `json:"condition"`
+	Label     string   `json:"label"`
+	Workflow  string   `json:"workflow"`
+	Status    string   `json:"status"`
+	Operator  string   `json:"operator"`
+	Value     int      `json:"value"`
+	Duration  Duration `json:"duration"`
+}
+
+// Notifier types supported in the configuration
+const (
+	NotifierTypeWebhook = "webhook"
+	NotifierTypeSlack   = "slack"
+	NotifierTypeCommand = "command"
+)
+
+// NotifierConfig describes a destination for alert events
+type NotifierConfig struct {
+	Type string `json:"type"`
+	// URL is used by webhook and slack notifiers
+	URL string `json:"url"`
+	// Headers are extra HTTP headers sent by webhook notifiers
+	Headers map[string]string `json:"headers"`
+	// Command is the program and arguments run by command notifiers
+	Command []string `json:"command"`
+}
+
+// Validate checks that the notifier type is known and has what it needs to send events
+func (n NotifierConfig) Validate() error {
+	switch n.Type {
+	case NotifierTypeWebhook, NotifierTypeSlack:
+		if n.URL == "" {
+			return fmt.Errorf("%s notifier requires a url", n.Type)
+		}
+	case NotifierTypeCommand:
+		if len(n.Command) == 0 {
+			return fmt.Errorf("command notifier requires a command")
+		}
+	default:
+		return fmt.Errorf("unknown notifier type %q", n.Type)
+	}
+	return nil
+}
+
+// Duration is a time.Duration that is written as a string such as "1h30m" in JSON
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string using time.ParseDuration
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON writes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Load loads the configuration from a JSON file
+func Load(path string) (*Config, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(file, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// JobThresholds converts the thresholds section into the domain value object
+func (c *Config) JobThresholds() value_object.JobThresholds {
+	thresholds := value_object.DefaultJobThresholds()
+	if c.Thresholds.LongRunning != nil {
+		thresholds.LongRunning = time.Duration(*c.Thresholds.LongRunning)
+	}
+	if c.Thresholds.LongQueued != nil {
+		thresholds.LongQueued = time.Duration(*c.Thresholds.LongQueued)
+	}
+	thresholds.LongRunningByWorkflow = toDurationMap(c.Thresholds.Workflows)
+	thresholds.LongRunningByLabel = toDurationMap(c.Thresholds.Labels)
+	return thresholds
+}
+
+// toDurationMap converts a map of config durations into a map of time.Duration
+func toDurationMap(m map[string]Duration) map[string]time.Duration {
+	if len(m) == 0 {
+		return nil
+	}
+	result := make(map[string]time.Duration, len(m))
+	for k, v := range m {
+		result[k] = time.Duration(v)
+	}
+	return result
+}
+
+// AlertRules converts and validates the alert rules
+func (c *Config) AlertRules() ([]value_object.AlertRule, error) {
+	rules := make([]value_object.AlertRule, 0, len(c.Alerts.Rules))
+	names := make(map[string]bool, len(c.Alerts.Rules))
+	for _, r := range c.Alerts.Rules {
+		rule := value_object.AlertRule{
+			Name:      r.Name,
+			Condition: value_object.AlertCondition(r.Condition),
+			Label:     r.Label,
+			Workflow:  r.Workflow,
+			Status:    r.Status,
+			Operator:  r.Operator,
+			Value:     r.Value,
+			Duration:  time.Duration(r.Duration),
+		}
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("duplicate alert rule name %q", rule.Name)
+		}
+		names[rule.Name] = true
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// AlertNotifiers validates and returns the notifiers alert events are sent to
+func (c *Config) AlertNotifiers() ([]NotifierConfig, error) {
+	for _, n := range c.Alerts.Notifiers {
+		if err := n.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return c.Alerts.Notifiers, nil
+}
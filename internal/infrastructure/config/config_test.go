@@ -0,0 +1,260 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// loadJSON writes the JSON into a temporary file and loads it
+func loadJSON(t *testing.T, content string) (*Config, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	return Load(path)
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "empty configuration",
+			content: `{}`,
+		},
+		{
+			name:    "valid durations",
+			content: `{"thresholds": {"long_running": "1h30m", "workflows": {"Nightly": "3h"}}}`,
+		},
+		{
+			name:    "duration without a unit",
+			content: `{"thresholds": {"long_running": "90"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "duration that is not a string",
+			content: `{"thresholds": {"long_queued": 900}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration in an alert rule",
+			content: `{"alerts": {"rules": [{"name": "slow", "condition": "job_duration", "duration": "soon"}]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed JSON",
+			content: `{"thresholds": `,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadJSON(t, tt.content)
+			if tt.wantErr && err == nil {
+				t.Error("Expected an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestConfig_JobThresholds(t *testing.T) {
+	cfg, err := loadJSON(t, `{"thresholds": {"long_queued": "5m", "labels": {"gpu": "4h"}}}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	thresholds := cfg.JobThresholds()
+	defaults := value_object.DefaultJobThresholds()
+	if thresholds.LongRunning != defaults.LongRunning {
+		t.Errorf("Expected the default long running threshold %s, got %s", defaults.LongRunning, thresholds.LongRunning)
+	}
+	if thresholds.LongQueued != 5*time.Minute {
+		t.Errorf("Expected long queued threshold 5m, got %s", thresholds.LongQueued)
+	}
+	if thresholds.LongRunningByLabel["gpu"] != 4*time.Hour {
+		t.Errorf("Expected gpu threshold 4h, got %s", thresholds.LongRunningByLabel["gpu"])
+	}
+	if thresholds.LongRunningByWorkflow != nil {
+		t.Errorf("Expected no workflow thresholds, got %v", thresholds.LongRunningByWorkflow)
+	}
+}
+
+func TestConfig_AlertRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		rules     []AlertRuleConfig
+		wantErr   bool
+		wantRules int
+	}{
+		{
+			name: "valid rules",
+			rules: []AlertRuleConfig{
+				{Name: "no-idle", Condition: "runner_count", Status: "idle", Operator: "==", Value: 0},
+				{Name: "slow", Condition: "job_duration", Workflow: "CI", Duration: Duration(time.Hour)},
+				{Name: "queued", Condition: "queue_wait", Label: "gpu", Duration: Duration(10 * time.Minute)},
+				{Name: "offline", Condition: "runner_offline", Duration: Duration(5 * time.Minute)},
+			},
+			wantRules: 4,
+		},
+		{
+			name:  "no rules",
+			rules: nil,
+		},
+		{
+			name:    "unknown condition",
+			rules:   []AlertRuleConfig{{Name: "disk", Condition: "disk_usage"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			rules:   []AlertRuleConfig{{Condition: "runner_count", Operator: ">", Value: 1}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid operator",
+			rules:   []AlertRuleConfig{{Name: "count", Condition: "runner_count", Operator: "~", Value: 1}},
+			wantErr: true,
+		},
+		{
+			name:    "missing duration",
+			rules:   []AlertRuleConfig{{Name: "slow", Condition: "job_duration"}},
+			wantErr: true,
+		},
+		{
+			name:    "negative duration",
+			rules:   []AlertRuleConfig{{Name: "slow", Condition: "queue_wait", Duration: Duration(-time.Minute)}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate names",
+			rules: []AlertRuleConfig{
+				{Name: "slow", Condition: "job_duration", Duration: Duration(time.Hour)},
+				{Name: "slow", Condition: "queue_wait", Duration: Duration(time.Hour)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Alerts: AlertsConfig{Rules: tt.rules}}
+
+			rules, err := cfg.AlertRules()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(rules) != tt.wantRules {
+				t.Errorf("Expected %d rules, got %d", tt.wantRules, len(rules))
+			}
+		})
+	}
+
+	t.Run("converts the rule fields", func(t *testing.T) {
+		cfg, err := loadJSON(t, `{"alerts": {"rules": [
+			{"name": "slow", "condition": "job_duration", "workflow": "CI", "label": "gpu", "duration": "45m"}
+		]}}`)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		rules, err := cfg.AlertRules()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := value_object.AlertRule{
+			Name:      "slow",
+			Condition: value_object.ConditionJobDuration,
+			Workflow:  "CI",
+			Label:     "gpu",
+			Duration:  45 * time.Minute,
+		}
+		if len(rules) != 1 || rules[0] != expected {
+			t.Errorf("Expected %+v, got %+v", expected, rules)
+		}
+	})
+}
+
+func TestConfig_AlertNotifiers(t *testing.T) {
+	tests := []struct {
+		name      string
+		notifiers []NotifierConfig
+		wantErr   bool
+	}{
+		{
+			name: "valid notifiers",
+			notifiers: []NotifierConfig{
+				{Type: NotifierTypeWebhook, URL: "https://example.com/hook", Headers: map[string]string{"X-Token": "secret"}},
+				{Type: NotifierTypeSlack, URL: "https://hooks.slack.com/services/T/B/X"},
+				{Type: NotifierTypeCommand, Command: []string{"notify-send", "runner alert"}},
+			},
+		},
+		{
+			name:      "unknown type",
+			notifiers: []NotifierConfig{{Type: "email", URL: "mailto:ops@example.com"}},
+			wantErr:   true,
+		},
+		{
+			name:      "missing type",
+			notifiers: []NotifierConfig{{URL: "https://example.com/hook"}},
+			wantErr:   true,
+		},
+		{
+			name:      "webhook without a url",
+			notifiers: []NotifierConfig{{Type: NotifierTypeWebhook}},
+			wantErr:   true,
+		},
+		{
+			name:      "slack without a url",
+			notifiers: []NotifierConfig{{Type: NotifierTypeSlack}},
+			wantErr:   true,
+		},
+		{
+			name:      "command without a command",
+			notifiers: []NotifierConfig{{Type: NotifierTypeCommand}},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Alerts: AlertsConfig{Notifiers: tt.notifiers}}
+
+			notifiers, err := cfg.AlertNotifiers()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(notifiers) != len(tt.notifiers) {
+				t.Errorf("Expected %d notifiers, got %d", len(tt.notifiers), len(notifiers))
+			}
+		})
+	}
+}
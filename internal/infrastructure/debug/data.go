@@ -3,7 +3,10 @@ package debug
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
@@ -11,14 +14,46 @@ import (
 
 // Data represents the structure of debug JSON data
 type Data struct {
+	// RecordedAt is the wall-clock time a recorded snapshot was captured, unset for hand-written data
+	RecordedAt  time.Time        `json:"RecordedAt,omitzero"`
 	CurrentTime time.Time        `json:"CurrentTime"`
 	Runners     []*entity.Runner `json:"runners"`
 	Jobs        []*entity.Job    `json:"jobs"`
+	// Downloads lists the runner application packages, optional
+	Downloads []*RunnerDownload `json:"downloads,omitempty"`
+	// Logs holds the job logs by job ID, optional
+	Logs map[int64]string `json:"logs,omitempty"`
+	// Scenario scripts changes over simulated time, optional
+	Scenario *Scenario `json:"scenario,omitempty"`
+
+	// startTime is the simulated time the scenario started at
+	startTime time.Time
+
+	// mu guards the data against concurrent modification by management actions
+	mu sync.RWMutex
+}
+
+// RunnerDownload is a runner application package in debug data, written like the GitHub API does
+type RunnerDownload struct {
+	OS             string `json:"os"`
+	Architecture   string `json:"architecture"`
+	DownloadURL    string `json:"download_url"`
+	Filename       string `json:"filename"`
+	SHA256Checksum string `json:"sha256_checksum"`
 }
 
 // LoadDebugData loads debug data from a JSON file
+// Session files written by SessionRecorder (.jsonl) are loaded from their first snapshot
 // This is a helper function for creating all debug repositories
 func LoadDebugData(jsonPath string) (*Data, error) {
+	if IsSessionFile(jsonPath) {
+		snapshots, err := LoadSession(jsonPath)
+		if err != nil {
+			return nil, err
+		}
+		return snapshots[0], nil
+	}
+
 	file, err := os.ReadFile(jsonPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
@@ -29,5 +64,45 @@ func LoadDebugData(jsonPath string) (*Data, error) {
 		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
 
+	if data.Scenario != nil {
+		data.startTime = data.CurrentTime
+		if err := data.Scenario.start(time.Now); err != nil {
+			return nil, err
+		}
+	}
+
 	return &data, nil
 }
+
+// IsSessionFile returns true if the path names a session file written by SessionRecorder
+func IsSessionFile(path string) bool {
+	return filepath.Ext(path) == sessionFileExt
+}
+
+// LoadSession loads every snapshot of a session file written by SessionRecorder
+func LoadSession(path string) ([]*Data, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var snapshots []*Data
+	decoder := json.NewDecoder(file)
+	for {
+		var data Data
+		if err := decoder.Decode(&data); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal snapshot %d: %w", len(snapshots)+1, err)
+		}
+		snapshots = append(snapshots, &data)
+	}
+
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("session file %s contains no snapshots", path)
+	}
+	return snapshots, nil
+}
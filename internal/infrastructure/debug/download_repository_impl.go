@@ -0,0 +1,35 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// DownloadRepositoryImpl is a download repository implementation that loads data from a JSON file
+type DownloadRepositoryImpl struct {
+	data *Data
+}
+
+// NewDownloadRepository creates a new debug download repository from loaded data
+func NewDownloadRepository(data *Data) repository.DownloadRepository {
+	return &DownloadRepositoryImpl{
+		data: data,
+	}
+}
+
+// FetchRunnerDownloads returns the downloads from the debug data
+func (d *DownloadRepositoryImpl) FetchRunnerDownloads(_ context.Context, _ value_object.RunnerScope) ([]*value_object.RunnerDownload, error) {
+	downloads := make([]*value_object.RunnerDownload, 0, len(d.data.Downloads))
+	for _, download := range d.data.Downloads {
+		downloads = append(downloads, &value_object.RunnerDownload{
+			OS:             download.OS,
+			Architecture:   download.Architecture,
+			DownloadURL:    download.DownloadURL,
+			Filename:       download.Filename,
+			SHA256Checksum: download.SHA256Checksum,
+		})
+	}
+	return downloads, nil
+}
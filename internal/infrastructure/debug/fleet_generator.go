@@ -0,0 +1,158 @@
+package debug
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+// FleetOptions configures the synthetic fleet produced by GenerateFleet
+type FleetOptions struct {
+	// Runners is the number of runners
+	Runners int
+	// Jobs is the number of active jobs, running on idle online runners first and queued otherwise
+	Jobs int
+	// OfflineRatio is the share of runners that are offline
+	OfflineRatio float64
+	// QueuedRatio is the share of jobs that are queued even when runners are available
+	QueuedRatio float64
+	// Seed makes the generated fleet reproducible
+	Seed uint64
+	// CurrentTime is the time of the snapshot, times of jobs are relative to it
+	CurrentTime time.Time
+}
+
+// weighted is a value picked with a relative weight
+type weighted struct {
+	value  string
+	weight int
+}
+
+// Distributions modeled after a typical mixed self-hosted fleet
+var (
+	osWeights = []weighted{{"linux", 70}, {"windows", 15}, {"macos", 15}}
+	// customLabelShares is the percentage of runners carrying each custom label
+	customLabelShares = []weighted{
+		{"docker", 40}, {"large", 15}, {"production", 10}, {"gpu", 5},
+		{"team-a", 20}, {"team-b", 20}, {"team-c", 10},
+	}
+	workflowNames = []string{"CI", "Release", "Nightly", "E2E Tests", "Deploy", "Lint", "Docs"}
+	jobNames      = []string{"build", "test", "lint", "package", "deploy", "integration", "e2e"}
+)
+
+// Number of repositories the generated jobs are spread across
+const generatedRepositories = 50
+
+// GenerateFleet creates debug data with the requested number of runners and jobs
+// Labels, operating systems and job assignments follow realistic distributions
+func GenerateFleet(opts FleetOptions) *Data {
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed))
+	now := opts.CurrentTime
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	data := &Data{
+		CurrentTime: now,
+		Runners:     make([]*entity.Runner, 0, opts.Runners),
+		Jobs:        make([]*entity.Job, 0, opts.Jobs),
+	}
+
+	var available []*entity.Runner
+	for i := range opts.Runners {
+		runner := generateRunner(rng, int64(i+1), now)
+		if rng.Float64() < opts.OfflineRatio {
+			runner.Status = entity.StatusOffline
+		} else {
+			available = append(available, runner)
+		}
+		data.Runners = append(data.Runners, runner)
+	}
+	rng.Shuffle(len(available), func(i, j int) { available[i], available[j] = available[j], available[i] })
+
+	for i := range opts.Jobs {
+		job := generateJob(rng, int64(i+1))
+		if len(available) > 0 && rng.Float64() >= opts.QueuedRatio {
+			runner := available[len(available)-1]
+			available = available[:len(available)-1]
+
+			startedAt := now.Add(-time.Duration(rng.IntN(90*60)) * time.Second)
+			createdAt := startedAt.Add(-time.Duration(rng.IntN(5*60)) * time.Second)
+			job.Status = "in_progress"
+			job.RunnerID = &runner.ID
+			job.RunnerName = &runner.Name
+			job.Labels = runner.Labels
+			job.StartedAt = &startedAt
+			job.CreatedAt = &createdAt
+			runner.Status = entity.StatusActive
+		} else {
+			// Queued jobs ask for the labels of an arbitrary runner so that capacity views have matches
+			if len(data.Runners) > 0 {
+				job.Labels = data.Runners[rng.IntN(len(data.Runners))].Labels
+			}
+			createdAt := now.Add(-time.Duration(rng.IntN(30*60)) * time.Second)
+			job.CreatedAt = &createdAt
+		}
+		data.Jobs = append(data.Jobs, job)
+	}
+
+	return data
+}
+
+// generateRunner creates an idle runner with a random platform and custom labels
+func generateRunner(rng *rand.Rand, id int64, now time.Time) *entity.Runner {
+	os := pick(rng, osWeights)
+	arch := "x64"
+	if os == "macos" && rng.IntN(100) < 80 || os == "linux" && rng.IntN(100) < 20 {
+		arch = "arm64"
+	}
+
+	labels := []string{"self-hosted", os, arch}
+	for _, label := range customLabelShares {
+		if rng.IntN(100) < label.weight {
+			labels = append(labels, label.value)
+		}
+	}
+
+	return &entity.Runner{
+		ID:        id,
+		Name:      fmt.Sprintf("%s-%s-%05d", os, arch, id),
+		Status:    entity.StatusIdle,
+		Labels:    labels,
+		OS:        os,
+		UpdatedAt: now,
+	}
+}
+
+// generateJob creates a queued job of a random workflow and repository
+func generateJob(rng *rand.Rand, id int64) *entity.Job {
+	repository := fmt.Sprintf("owner/repo-%02d", rng.IntN(generatedRepositories)+1)
+	runID := 100000 + id
+	return &entity.Job{
+		ID:           id,
+		RunID:        runID,
+		Name:         jobNames[rng.IntN(len(jobNames))],
+		Status:       "queued",
+		WorkflowName: workflowNames[rng.IntN(len(workflowNames))],
+		Repository:   repository,
+		HtmlUrl:      fmt.Sprintf("https://github.com/%s/actions/runs/%d/job/%d", repository, runID, id),
+	}
+}
+
+// pick returns a value chosen according to the weights
+func pick(rng *rand.Rand, values []weighted) string {
+	total := 0
+	for _, v := range values {
+		total += v.weight
+	}
+	n := rng.IntN(total)
+	for _, v := range values {
+		if n < v.weight {
+			return v.value
+		}
+		n -= v.weight
+	}
+	return values[len(values)-1].value
+}
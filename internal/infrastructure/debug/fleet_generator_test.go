@@ -0,0 +1,112 @@
+package debug
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/service"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestGenerateFleet(t *testing.T) {
+	now := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts FleetOptions
+	}{
+		{name: "no runners", opts: FleetOptions{Jobs: 20, Seed: 1}},
+		{name: "more runners than jobs", opts: FleetOptions{Runners: 2000, Jobs: 500, OfflineRatio: 0.1, QueuedRatio: 0.2, Seed: 1}},
+		{name: "more jobs than runners", opts: FleetOptions{Runners: 100, Jobs: 1000, OfflineRatio: 0.3, Seed: 2}},
+		{name: "everything queued", opts: FleetOptions{Runners: 100, Jobs: 100, QueuedRatio: 1, Seed: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.CurrentTime = now
+			data := GenerateFleet(tt.opts)
+
+			if len(data.Runners) != tt.opts.Runners || len(data.Jobs) != tt.opts.Jobs {
+				t.Fatalf("Expected %d runners and %d jobs, got %d and %d", tt.opts.Runners, tt.opts.Jobs, len(data.Runners), len(data.Jobs))
+			}
+			if !data.CurrentTime.Equal(now) {
+				t.Errorf("Expected the snapshot time %v, got %v", now, data.CurrentTime)
+			}
+
+			offline, active := 0, 0
+			for _, runner := range data.Runners {
+				switch runner.Status {
+				case entity.StatusOffline:
+					offline++
+				case entity.StatusActive:
+					active++
+				}
+			}
+			running := 0
+			busy := make(map[int64]bool)
+			for _, job := range data.Jobs {
+				if job.Status != "in_progress" {
+					continue
+				}
+				running++
+				if job.RunnerID == nil || busy[*job.RunnerID] {
+					t.Fatalf("Expected each running job on its own runner, got %v", job.RunnerID)
+				}
+				busy[*job.RunnerID] = true
+			}
+
+			if running != active {
+				t.Errorf("Expected %d active runners for the running jobs, got %d", running, active)
+			}
+			if tt.opts.Runners > 0 {
+				assertRatio(t, "offline runners", float64(offline)/float64(tt.opts.Runners), tt.opts.OfflineRatio)
+			}
+			online := tt.opts.Runners - offline
+			if online >= tt.opts.Jobs {
+				// Every job could run, so only the queued ratio keeps jobs waiting
+				assertRatio(t, "queued jobs", float64(tt.opts.Jobs-running)/float64(tt.opts.Jobs), tt.opts.QueuedRatio)
+			} else if tt.opts.QueuedRatio == 0 && running != online {
+				t.Errorf("Expected all %d online runners to be busy, got %d", online, running)
+			}
+		})
+	}
+}
+
+func TestGenerateFleet_Seed(t *testing.T) {
+	opts := FleetOptions{Runners: 200, Jobs: 100, OfflineRatio: 0.1, QueuedRatio: 0.2, Seed: 42, CurrentTime: time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)}
+
+	if !reflect.DeepEqual(GenerateFleet(opts), GenerateFleet(opts)) {
+		t.Error("Expected the same seed to generate the same fleet")
+	}
+
+	opts.Seed = 43
+	other := GenerateFleet(opts)
+	opts.Seed = 42
+	if reflect.DeepEqual(GenerateFleet(opts).Runners, other.Runners) {
+		t.Error("Expected another seed to generate another fleet")
+	}
+}
+
+// assertRatio fails the test if the observed ratio is far from the expected one
+func assertRatio(t *testing.T, name string, observed, expected float64) {
+	t.Helper()
+	if math.Abs(observed-expected) > 0.05 {
+		t.Errorf("Expected about %.2f %s, got %.2f", expected, name, observed)
+	}
+}
+
+func BenchmarkUpdateRunnerStatus(b *testing.B) {
+	sizes := []struct{ runners, jobs int }{{100, 40}, {1000, 400}, {5000, 2000}}
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("runners=%d/jobs=%d", size.runners, size.jobs), func(b *testing.B) {
+			data := GenerateFleet(FleetOptions{Runners: size.runners, Jobs: size.jobs, OfflineRatio: 0.05, QueuedRatio: 0.2, Seed: 1})
+			for b.Loop() {
+				service.UpdateRunnerStatus(data.Runners, value_object.NewJobIndex(data.Runners, data.Jobs))
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package debug
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
@@ -20,5 +22,65 @@ func NewJobRepository(data *Data) repository.JobRepository {
 }
 
 func (j *JobRepositoryImpl) FetchActiveJobs(_ context.Context, _, _, _ string) ([]*entity.Job, error) {
-	return j.data.Jobs, nil
+	j.data.sync()
+	j.data.mu.RLock()
+	defer j.data.mu.RUnlock()
+	// Copies keep every snapshot independent, so that it can be compared with the next one
+	return cloneJobs(j.data.Jobs), nil
+}
+
+// CancelWorkflowRun removes the jobs of the run from the in-memory debug data
+func (j *JobRepositoryImpl) CancelWorkflowRun(_ context.Context, _, _ string, runID int64) error {
+	j.data.mu.Lock()
+	defer j.data.mu.Unlock()
+
+	jobs := slices.DeleteFunc(slices.Clone(j.data.Jobs), func(job *entity.Job) bool { return job.RunID == runID })
+	if len(jobs) == len(j.data.Jobs) {
+		return fmt.Errorf("workflow run %d not found", runID)
+	}
+	j.data.Jobs = jobs
+	return nil
+}
+
+// ForceCancelWorkflowRun behaves like CancelWorkflowRun in debug mode
+func (j *JobRepositoryImpl) ForceCancelWorkflowRun(ctx context.Context, owner, repo string, runID int64) error {
+	return j.CancelWorkflowRun(ctx, owner, repo, runID)
+}
+
+// RerunFailedJobs is not supported in debug mode because only active jobs are known
+func (j *JobRepositoryImpl) RerunFailedJobs(_ context.Context, _, _ string, runID int64) error {
+	return fmt.Errorf("workflow run %d has no failed jobs", runID)
+}
+
+// RerunJob puts the job back into the queue in the in-memory debug data
+func (j *JobRepositoryImpl) RerunJob(_ context.Context, _, _ string, jobID int64) error {
+	j.data.mu.Lock()
+	defer j.data.mu.Unlock()
+
+	index := slices.IndexFunc(j.data.Jobs, func(job *entity.Job) bool { return job.ID == jobID })
+	if index < 0 {
+		return fmt.Errorf("job %d not found", jobID)
+	}
+
+	// Replace the job instead of modifying it so that snapshots already handed out are not changed
+	requeued := *j.data.Jobs[index]
+	requeued.Status = "queued"
+	requeued.RunnerID = nil
+	requeued.RunnerName = nil
+	requeued.StartedAt = nil
+	j.data.Jobs = slices.Clone(j.data.Jobs)
+	j.data.Jobs[index] = &requeued
+	return nil
+}
+
+// FetchJobLog returns the log recorded for the job in the debug data
+func (j *JobRepositoryImpl) FetchJobLog(_ context.Context, _, _ string, jobID int64) (string, error) {
+	j.data.mu.RLock()
+	defer j.data.mu.RUnlock()
+
+	log, ok := j.data.Logs[jobID]
+	if !ok {
+		return "", fmt.Errorf("no log of job %d in the debug data", jobID)
+	}
+	return log, nil
 }
@@ -0,0 +1,157 @@
+package debug
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// Player plays back a recorded timeline of snapshots through the debug repositories
+// It observes the snapshots of the monitor to advance after every refresh
+type Player struct {
+	mu        sync.Mutex
+	snapshots []*Data
+	live      *Data
+	index     int
+	paused    bool
+	speed     float64
+
+	// anchorIndex and anchorAt are the snapshot and wall-clock time playback last (re)started from
+	anchorIndex int
+	anchorAt    time.Time
+	now         func() time.Time
+}
+
+// NewPlayer creates a player positioned at the first snapshot
+// A speed of zero advances one snapshot per refresh, otherwise the recorded timing is replayed at that multiple
+func NewPlayer(snapshots []*Data, speed float64) *Player {
+	p := &Player{
+		snapshots: snapshots,
+		live:      &Data{},
+		speed:     speed,
+		now:       time.Now,
+	}
+	p.seek(0)
+	return p
+}
+
+// Data returns the data the debug repositories must be created from
+func (p *Player) Data() *Data {
+	return p.live
+}
+
+// Observe advances playback after a snapshot has been taken
+func (p *Player) Observe(_ context.Context, _ *value_object.MonitorData) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.paused {
+		return nil
+	}
+
+	next := p.index + 1
+	if p.speed > 0 {
+		// Move to the last snapshot recorded before the scaled elapsed time
+		elapsed := time.Duration(float64(p.now().Sub(p.anchorAt)) * p.speed)
+		target := p.snapshots[p.anchorIndex].CurrentTime.Add(elapsed)
+		next = p.indexAt(target)
+	}
+	// Stay on the last snapshot at the end of the recording
+	next = min(next, len(p.snapshots)-1)
+	p.apply(next)
+	if next == len(p.snapshots)-1 {
+		p.paused = true
+	}
+	return nil
+}
+
+// TogglePause pauses or resumes playback and returns true if playback is now paused
+func (p *Player) TogglePause() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.paused = !p.paused
+	if !p.paused {
+		if p.index == len(p.snapshots)-1 {
+			// Resuming at the end starts over
+			p.seek(0)
+		} else {
+			p.seek(p.index)
+		}
+	}
+	return p.paused
+}
+
+// Step moves delta snapshots forward (or backward when negative) and pauses playback
+func (p *Player) Step(delta int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.paused = true
+	p.seek(min(max(p.index+delta, 0), len(p.snapshots)-1))
+}
+
+// JumpTo moves to the last snapshot recorded at or before t and pauses playback
+func (p *Player) JumpTo(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.paused = true
+	p.seek(min(max(p.indexAt(t), 0), len(p.snapshots)-1))
+}
+
+// Position returns the current playback position
+func (p *Player) Position() value_object.ReplayPosition {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return value_object.ReplayPosition{
+		Index:  p.index,
+		Count:  len(p.snapshots),
+		Time:   p.snapshots[p.index].CurrentTime,
+		Paused: p.paused,
+		Speed:  p.speed,
+	}
+}
+
+// indexAt returns the index of the last snapshot recorded at or before t, or -1 if there is none
+func (p *Player) indexAt(t time.Time) int {
+	return sort.Search(len(p.snapshots), func(i int) bool {
+		return p.snapshots[i].CurrentTime.After(t)
+	}) - 1
+}
+
+// seek moves to the snapshot and restarts timed playback from it
+func (p *Player) seek(index int) {
+	p.anchorIndex = index
+	p.anchorAt = p.now()
+	p.apply(index)
+}
+
+// apply copies the snapshot into the live data
+// Runners and jobs are copied so that in-place changes never leak into the recording
+func (p *Player) apply(index int) {
+	p.index = index
+	snapshot := p.snapshots[index]
+
+	runners := make([]*entity.Runner, 0, len(snapshot.Runners))
+	for _, runner := range snapshot.Runners {
+		r := *runner
+		runners = append(runners, &r)
+	}
+	jobs := make([]*entity.Job, 0, len(snapshot.Jobs))
+	for _, job := range snapshot.Jobs {
+		j := *job
+		jobs = append(jobs, &j)
+	}
+
+	p.live.mu.Lock()
+	defer p.live.mu.Unlock()
+	p.live.CurrentTime = snapshot.CurrentTime
+	p.live.Runners = runners
+	p.live.Jobs = jobs
+}
@@ -0,0 +1,186 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+var playerStart = time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+
+// newTestPlayer creates a player over snapshots recorded at the offsets, driven by the returned clock
+func newTestPlayer(speed float64, offsets ...time.Duration) (*Player, *time.Time) {
+	snapshots := make([]*Data, 0, len(offsets))
+	for i, offset := range offsets {
+		snapshots = append(snapshots, &Data{
+			CurrentTime: playerStart.Add(offset),
+			Runners:     []*entity.Runner{{ID: int64(i + 1), Name: "runner", Labels: []string{"linux"}}},
+		})
+	}
+
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	player := NewPlayer(snapshots, speed)
+	player.now = func() time.Time { return clock }
+	player.seek(0)
+	return player, &clock
+}
+
+func TestPlayer_Observe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("advances one snapshot per refresh without a speed", func(t *testing.T) {
+		player, _ := newTestPlayer(0, 0, time.Minute, 2*time.Minute)
+
+		for _, expected := range []int{1, 2, 2} {
+			_ = player.Observe(ctx, nil)
+			if index := player.Position().Index; index != expected {
+				t.Errorf("Expected index %d, got %d", expected, index)
+			}
+		}
+		if player.Data().Runners[0].ID != 3 {
+			t.Errorf("Expected the runners of the last snapshot, got %+v", player.Data().Runners)
+		}
+	})
+
+	t.Run("follows the recorded timing scaled by the speed", func(t *testing.T) {
+		player, clock := newTestPlayer(2, 0, 10*time.Second, 20*time.Second, time.Minute)
+
+		steps := []struct {
+			advance  time.Duration
+			expected int
+		}{
+			{advance: 4 * time.Second, expected: 0},
+			{advance: time.Second, expected: 1},
+			{advance: 10 * time.Second, expected: 2},
+			{advance: 10 * time.Second, expected: 2},
+			{advance: 5 * time.Second, expected: 3},
+		}
+		for _, step := range steps {
+			*clock = clock.Add(step.advance)
+			_ = player.Observe(ctx, nil)
+			if index := player.Position().Index; index != step.expected {
+				t.Errorf("After %v: expected index %d, got %d", step.advance, step.expected, index)
+			}
+		}
+	})
+
+	t.Run("pauses at the end of the recording", func(t *testing.T) {
+		player, clock := newTestPlayer(1, 0, time.Minute)
+
+		*clock = clock.Add(time.Hour)
+		_ = player.Observe(ctx, nil)
+
+		position := player.Position()
+		if position.Index != 1 || !position.Paused {
+			t.Errorf("Expected to pause on the last snapshot, got %+v", position)
+		}
+	})
+
+	t.Run("does not advance while paused", func(t *testing.T) {
+		player, clock := newTestPlayer(1, 0, time.Minute, 2*time.Minute)
+
+		if !player.TogglePause() {
+			t.Fatal("Expected playback to be paused")
+		}
+		*clock = clock.Add(time.Hour)
+		_ = player.Observe(ctx, nil)
+		if index := player.Position().Index; index != 0 {
+			t.Errorf("Expected to stay on the first snapshot, got %d", index)
+		}
+
+		// Resuming restarts the timing from now, so the paused hour is not played back
+		if player.TogglePause() {
+			t.Fatal("Expected playback to be resumed")
+		}
+		*clock = clock.Add(time.Minute)
+		_ = player.Observe(ctx, nil)
+		if index := player.Position().Index; index != 1 {
+			t.Errorf("Expected to advance one minute after resuming, got %d", index)
+		}
+	})
+
+	t.Run("resuming at the end starts over", func(t *testing.T) {
+		player, _ := newTestPlayer(0, 0, time.Minute)
+
+		_ = player.Observe(ctx, nil)
+		if player.TogglePause() {
+			t.Fatal("Expected playback to be resumed")
+		}
+
+		position := player.Position()
+		if position.Index != 0 || position.Paused {
+			t.Errorf("Expected to play from the first snapshot, got %+v", position)
+		}
+	})
+}
+
+func TestPlayer_Step(t *testing.T) {
+	tests := []struct {
+		name     string
+		deltas   []int
+		expected int
+	}{
+		{name: "moves forward", deltas: []int{1}, expected: 1},
+		{name: "moves backward", deltas: []int{2, -1}, expected: 1},
+		{name: "stops at the first snapshot", deltas: []int{1, -5}, expected: 0},
+		{name: "stops at the last snapshot", deltas: []int{10}, expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player, _ := newTestPlayer(1, 0, time.Minute, 2*time.Minute)
+
+			for _, delta := range tt.deltas {
+				player.Step(delta)
+			}
+
+			position := player.Position()
+			if position.Index != tt.expected || !position.Paused {
+				t.Errorf("Expected to pause at %d, got %+v", tt.expected, position)
+			}
+		})
+	}
+}
+
+func TestPlayer_JumpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   time.Duration
+		expected int
+	}{
+		{name: "exact snapshot", target: time.Minute, expected: 1},
+		{name: "between snapshots", target: 90 * time.Second, expected: 1},
+		{name: "before the recording", target: -time.Hour, expected: 0},
+		{name: "after the recording", target: time.Hour, expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player, _ := newTestPlayer(1, 0, time.Minute, 2*time.Minute)
+
+			player.JumpTo(playerStart.Add(tt.target))
+
+			position := player.Position()
+			if position.Index != tt.expected || !position.Paused {
+				t.Errorf("Expected to pause at %d, got %+v", tt.expected, position)
+			}
+			if !player.Data().CurrentTime.Equal(position.Time) {
+				t.Errorf("Expected the data to be at %v, got %v", position.Time, player.Data().CurrentTime)
+			}
+		})
+	}
+}
+
+func TestPlayer_KeepsTheRecording(t *testing.T) {
+	player, _ := newTestPlayer(0, 0, time.Minute)
+
+	player.Data().Runners[0].Status = entity.StatusOffline
+	player.Step(1)
+	player.Step(-1)
+
+	if status := player.Data().Runners[0].Status; status == entity.StatusOffline {
+		t.Errorf("Expected changes to the live data not to leak into the recording, got %s", status)
+	}
+}
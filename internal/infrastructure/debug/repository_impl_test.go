@@ -0,0 +1,47 @@
+package debug
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+func TestRepositories_ReturnIndependentSnapshots(t *testing.T) {
+	ctx := context.Background()
+	data := &Data{
+		Runners: []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusIdle, Labels: []string{"linux"}}},
+		Jobs:    []*entity.Job{{ID: 10, Name: "build", Status: "queued", Labels: []string{"linux"}, Steps: []*entity.JobStep{{Number: 1}}}},
+	}
+	runnerRepo := NewRunnerRepository(data)
+	jobRepo := NewJobRepository(data)
+
+	t.Run("changing labels keeps earlier snapshots", func(t *testing.T) {
+		before, _ := runnerRepo.FetchRunners(ctx, "", "", "")
+
+		if err := runnerRepo.AddLabels(ctx, "", "", "", 1, []string{"gpu"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		after, _ := runnerRepo.FetchRunners(ctx, "", "", "")
+
+		if !slices.Equal(before[0].Labels, []string{"linux"}) {
+			t.Errorf("Expected the earlier snapshot to keep its labels, got %v", before[0].Labels)
+		}
+		if !slices.Equal(after[0].Labels, []string{"linux", "gpu"}) {
+			t.Errorf("Expected the added label, got %v", after[0].Labels)
+		}
+	})
+
+	t.Run("jobs are copied on every fetch", func(t *testing.T) {
+		first, _ := jobRepo.FetchActiveJobs(ctx, "", "", "")
+		first[0].Status = "in_progress"
+		first[0].Labels[0] = "changed"
+		first[0].Steps[0].Status = "completed"
+
+		second, _ := jobRepo.FetchActiveJobs(ctx, "", "", "")
+		if second[0] == first[0] || second[0].Status != "queued" || second[0].Labels[0] != "linux" || second[0].Steps[0].Status != "" {
+			t.Errorf("Expected an unchanged copy of the job, got %+v", second[0])
+		}
+	})
+}
@@ -2,6 +2,8 @@ package debug
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
@@ -19,8 +21,69 @@ func NewRunnerRepository(data *Data) repository.RunnerRepository {
 	}
 }
 
+func (d *RunnerRepositoryImpl) FetchRunners(_ context.Context, _, _, _ string) ([]*entity.Runner, error) {
+	d.data.sync()
+	d.data.mu.RLock()
+	defer d.data.mu.RUnlock()
+	// Copies keep the status reported by the data intact when it is reconciled with the jobs
+	return cloneRunners(d.data.Runners), nil
+}
 
+// DeleteRunner removes the runner from the in-memory debug data
+func (d *RunnerRepositoryImpl) DeleteRunner(_ context.Context, _, _, _ string, runnerID int64) error {
+	d.data.mu.Lock()
+	defer d.data.mu.Unlock()
 
-func (d *RunnerRepositoryImpl) FetchRunners(_ context.Context, _, _, _ string) ([]*entity.Runner, error) {
-	return d.data.Runners, nil
+	index := slices.IndexFunc(d.data.Runners, func(r *entity.Runner) bool { return r.ID == runnerID })
+	if index < 0 {
+		return fmt.Errorf("runner %d not found", runnerID)
+	}
+	// Build a new slice so that snapshots already handed out are not modified
+	d.data.Runners = slices.Delete(slices.Clone(d.data.Runners), index, index+1)
+	return nil
+}
+
+// AddLabels adds labels to the runner in the in-memory debug data
+func (d *RunnerRepositoryImpl) AddLabels(_ context.Context, _, _, _ string, runnerID int64, labels []string) error {
+	return d.updateLabels(runnerID, func(current []string) []string {
+		result := slices.Clone(current)
+		for _, label := range labels {
+			if !slices.Contains(result, label) {
+				result = append(result, label)
+			}
+		}
+		return result
+	})
+}
+
+// RemoveLabel removes a label from the runner in the in-memory debug data
+func (d *RunnerRepositoryImpl) RemoveLabel(_ context.Context, _, _, _ string, runnerID int64, label string) error {
+	return d.updateLabels(runnerID, func(current []string) []string {
+		return slices.DeleteFunc(slices.Clone(current), func(l string) bool { return l == label })
+	})
+}
+
+// SetLabels replaces the labels of the runner in the in-memory debug data
+func (d *RunnerRepositoryImpl) SetLabels(_ context.Context, _, _, _ string, runnerID int64, labels []string) error {
+	return d.updateLabels(runnerID, func([]string) []string {
+		return slices.Clone(labels)
+	})
+}
+
+// updateLabels replaces the labels of a runner with the result of update
+func (d *RunnerRepositoryImpl) updateLabels(runnerID int64, update func([]string) []string) error {
+	d.data.mu.Lock()
+	defer d.data.mu.Unlock()
+
+	index := slices.IndexFunc(d.data.Runners, func(r *entity.Runner) bool { return r.ID == runnerID })
+	if index < 0 {
+		return fmt.Errorf("runner %d not found", runnerID)
+	}
+
+	// Replace the runner instead of modifying it so that snapshots already handed out are not changed
+	updated := *d.data.Runners[index]
+	updated.Labels = update(updated.Labels)
+	d.data.Runners = slices.Clone(d.data.Runners)
+	d.data.Runners[index] = &updated
+	return nil
 }
@@ -0,0 +1,255 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+// ScenarioAction identifies the change a scenario event applies
+type ScenarioAction string
+
+const (
+	ActionRunnerOffline ScenarioAction = "runner_offline"
+	ActionRunnerOnline  ScenarioAction = "runner_online"
+	ActionRunnerAdded   ScenarioAction = "runner_added"
+	ActionRunnerRemoved ScenarioAction = "runner_removed"
+	ActionJobQueued     ScenarioAction = "job_queued"
+	ActionJobStarted    ScenarioAction = "job_started"
+	ActionJobFinished   ScenarioAction = "job_finished"
+)
+
+// Scenario scripts changes of the debug data over simulated time
+// The simulated clock starts at the CurrentTime of the data when the scenario is loaded
+type Scenario struct {
+	// Speed is the number of simulated seconds per real second, 1 when zero
+	Speed  float64         `json:"speed"`
+	Events []ScenarioEvent `json:"events"`
+
+	// Evaluation state
+	startedAt time.Time
+	next      int
+	now       func() time.Time
+}
+
+// ScenarioEvent is a change applied once the simulated clock reaches its offset
+type ScenarioEvent struct {
+	// At is the offset from the start of the scenario, e.g. "30s" or "2m"
+	At     Offset         `json:"at"`
+	Action ScenarioAction `json:"action"`
+	// RunnerID identifies the runner of runner actions and the runner a job starts on
+	RunnerID int64 `json:"runner_id"`
+	// JobID identifies the job of job_started and job_finished
+	JobID int64 `json:"job_id"`
+	// Runner is the runner created by runner_added
+	Runner *entity.Runner `json:"runner"`
+	// Job is the job created by job_queued
+	Job *entity.Job `json:"job"`
+	// Count queues this many copies of Job with consecutive IDs, 1 when zero
+	Count int `json:"count"`
+}
+
+// Offset is a duration written as a string such as "45s" in scenario files
+type Offset time.Duration
+
+// UnmarshalJSON parses the offset from a duration string
+func (o *Offset) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("offset must be a duration string: %w", err)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid offset %q: %w", s, err)
+	}
+	*o = Offset(d)
+	return nil
+}
+
+// MarshalJSON writes the offset as a duration string
+func (o Offset) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(o).String())
+}
+
+// start validates the events and starts the simulated clock
+func (s *Scenario) start(now func() time.Time) error {
+	for i, event := range s.Events {
+		if err := event.validate(); err != nil {
+			return fmt.Errorf("invalid scenario event %d: %w", i+1, err)
+		}
+	}
+	sort.SliceStable(s.Events, func(i, j int) bool { return s.Events[i].At < s.Events[j].At })
+	if s.Speed <= 0 {
+		s.Speed = 1
+	}
+	s.now = now
+	s.startedAt = now()
+	return nil
+}
+
+// elapsed returns the simulated time since the start of the scenario
+func (s *Scenario) elapsed() time.Duration {
+	return time.Duration(float64(s.now().Sub(s.startedAt)) * s.Speed)
+}
+
+// validate checks that the event carries what its action needs
+func (e ScenarioEvent) validate() error {
+	switch e.Action {
+	case ActionRunnerOffline, ActionRunnerOnline, ActionRunnerRemoved:
+		if e.RunnerID == 0 {
+			return fmt.Errorf("%s requires runner_id", e.Action)
+		}
+	case ActionRunnerAdded:
+		if e.Runner == nil {
+			return fmt.Errorf("%s requires runner", e.Action)
+		}
+	case ActionJobQueued:
+		if e.Job == nil {
+			return fmt.Errorf("%s requires job", e.Action)
+		}
+	case ActionJobStarted:
+		if e.JobID == 0 || e.RunnerID == 0 {
+			return fmt.Errorf("%s requires job_id and runner_id", e.Action)
+		}
+	case ActionJobFinished:
+		if e.JobID == 0 {
+			return fmt.Errorf("%s requires job_id", e.Action)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", e.Action)
+	}
+	return nil
+}
+
+// sync brings the data up to the simulated clock of its scenario
+// Runners and jobs are copied first so that snapshots handed out earlier never change
+func (d *Data) sync() {
+	if d.Scenario == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s := d.Scenario
+	elapsed := s.elapsed()
+	d.CurrentTime = d.startTime.Add(elapsed)
+	d.Runners = cloneRunners(d.Runners)
+	d.Jobs = cloneJobs(d.Jobs)
+
+	for s.next < len(s.Events) && time.Duration(s.Events[s.next].At) <= elapsed {
+		event := s.Events[s.next]
+		d.apply(event, d.startTime.Add(time.Duration(event.At)))
+		s.next++
+	}
+}
+
+// apply performs a scenario event that happened at the given time
+// Events referring to unknown runners or jobs are ignored
+func (d *Data) apply(event ScenarioEvent, at time.Time) {
+	switch event.Action {
+	case ActionRunnerOffline:
+		if runner := d.findRunner(event.RunnerID); runner != nil {
+			runner.Status = entity.StatusOffline
+		}
+	case ActionRunnerOnline:
+		if runner := d.findRunner(event.RunnerID); runner != nil {
+			runner.Status = entity.StatusIdle
+		}
+	case ActionRunnerAdded:
+		runner := *event.Runner
+		if runner.Status == "" {
+			runner.Status = entity.StatusIdle
+		}
+		d.Runners = append(d.Runners, &runner)
+	case ActionRunnerRemoved:
+		d.Runners = slices.DeleteFunc(d.Runners, func(r *entity.Runner) bool { return r.ID == event.RunnerID })
+	case ActionJobQueued:
+		for i := range max(event.Count, 1) {
+			job := *event.Job
+			job.ID += int64(i)
+			if event.Count > 1 {
+				job.Name = fmt.Sprintf("%s #%d", job.Name, i+1)
+			}
+			job.Status = "queued"
+			if job.CreatedAt == nil {
+				job.CreatedAt = &at
+			}
+			d.Jobs = append(d.Jobs, &job)
+		}
+	case ActionJobStarted:
+		job := d.findJob(event.JobID)
+		runner := d.findRunner(event.RunnerID)
+		if job == nil || runner == nil {
+			return
+		}
+		job.Status = "in_progress"
+		job.RunnerID = &runner.ID
+		job.RunnerName = &runner.Name
+		job.StartedAt = &at
+		// Like the busy flag reported by GitHub
+		runner.Status = entity.StatusActive
+	case ActionJobFinished:
+		job := d.findJob(event.JobID)
+		if job == nil {
+			return
+		}
+		if job.RunnerID != nil {
+			if runner := d.findRunner(*job.RunnerID); runner != nil && runner.IsActive() {
+				runner.Status = entity.StatusIdle
+			}
+		}
+		d.Jobs = slices.DeleteFunc(d.Jobs, func(j *entity.Job) bool { return j.ID == event.JobID })
+	}
+}
+
+// findRunner returns the runner with the ID, or nil if there is none
+func (d *Data) findRunner(id int64) *entity.Runner {
+	index := slices.IndexFunc(d.Runners, func(r *entity.Runner) bool { return r.ID == id })
+	if index < 0 {
+		return nil
+	}
+	return d.Runners[index]
+}
+
+// findJob returns the job with the ID, or nil if there is none
+func (d *Data) findJob(id int64) *entity.Job {
+	index := slices.IndexFunc(d.Jobs, func(j *entity.Job) bool { return j.ID == id })
+	if index < 0 {
+		return nil
+	}
+	return d.Jobs[index]
+}
+
+// cloneRunners returns deep copies of the runners
+func cloneRunners(runners []*entity.Runner) []*entity.Runner {
+	result := make([]*entity.Runner, 0, len(runners))
+	for _, runner := range runners {
+		r := *runner
+		r.Labels = slices.Clone(runner.Labels)
+		result = append(result, &r)
+	}
+	return result
+}
+
+// cloneJobs returns deep copies of the jobs
+func cloneJobs(jobs []*entity.Job) []*entity.Job {
+	result := make([]*entity.Job, 0, len(jobs))
+	for _, job := range jobs {
+		j := *job
+		j.Labels = slices.Clone(job.Labels)
+		if job.Steps != nil {
+			j.Steps = make([]*entity.JobStep, 0, len(job.Steps))
+			for _, step := range job.Steps {
+				s := *step
+				j.Steps = append(j.Steps, &s)
+			}
+		}
+		result = append(result, &j)
+	}
+	return result
+}
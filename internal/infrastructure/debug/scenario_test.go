@@ -0,0 +1,227 @@
+package debug
+
+import (
+	"maps"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+func TestData_Apply(t *testing.T) {
+	at := time.Date(2025, 11, 3, 10, 5, 0, 0, time.UTC)
+	runnerID := int64(1)
+
+	tests := []struct {
+		name    string
+		event   ScenarioEvent
+		runners map[int64]entity.RunnerStatus
+		jobs    map[int64]string
+	}{
+		{
+			name:    "runner_offline",
+			event:   ScenarioEvent{Action: ActionRunnerOffline, RunnerID: 2},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusOffline},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued"},
+		},
+		{
+			name:    "runner_online",
+			event:   ScenarioEvent{Action: ActionRunnerOnline, RunnerID: 1},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusIdle, 2: entity.StatusIdle},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued"},
+		},
+		{
+			name:    "runner_added defaults to idle",
+			event:   ScenarioEvent{Action: ActionRunnerAdded, Runner: &entity.Runner{ID: 3, Name: "runner-3"}},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusIdle, 3: entity.StatusIdle},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued"},
+		},
+		{
+			name:    "runner_removed",
+			event:   ScenarioEvent{Action: ActionRunnerRemoved, RunnerID: 2},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued"},
+		},
+		{
+			name:    "job_queued",
+			event:   ScenarioEvent{Action: ActionJobQueued, Job: &entity.Job{ID: 20, Name: "test"}},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusIdle},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued", 20: "queued"},
+		},
+		{
+			name:    "job_queued with a count",
+			event:   ScenarioEvent{Action: ActionJobQueued, Count: 3, Job: &entity.Job{ID: 20, Name: "test"}},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusIdle},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued", 20: "queued", 21: "queued", 22: "queued"},
+		},
+		{
+			name:    "job_started",
+			event:   ScenarioEvent{Action: ActionJobStarted, JobID: 11, RunnerID: 2},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusActive},
+			jobs:    map[int64]string{10: "in_progress", 11: "in_progress"},
+		},
+		{
+			name:    "job_started on an unknown runner",
+			event:   ScenarioEvent{Action: ActionJobStarted, JobID: 11, RunnerID: 9},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusIdle},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued"},
+		},
+		{
+			name:    "job_finished frees the runner",
+			event:   ScenarioEvent{Action: ActionJobFinished, JobID: 10},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusIdle, 2: entity.StatusIdle},
+			jobs:    map[int64]string{11: "queued"},
+		},
+		{
+			name:    "job_finished of an unknown job",
+			event:   ScenarioEvent{Action: ActionJobFinished, JobID: 99},
+			runners: map[int64]entity.RunnerStatus{1: entity.StatusActive, 2: entity.StatusIdle},
+			jobs:    map[int64]string{10: "in_progress", 11: "queued"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &Data{
+				Runners: []*entity.Runner{
+					{ID: 1, Name: "runner-1", Status: entity.StatusActive},
+					{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+				},
+				Jobs: []*entity.Job{
+					{ID: 10, Name: "build", Status: "in_progress", RunnerID: &runnerID},
+					{ID: 11, Name: "lint", Status: "queued"},
+				},
+			}
+
+			data.apply(tt.event, at)
+
+			runners := make(map[int64]entity.RunnerStatus, len(data.Runners))
+			for _, runner := range data.Runners {
+				runners[runner.ID] = runner.Status
+			}
+			jobs := make(map[int64]string, len(data.Jobs))
+			for _, job := range data.Jobs {
+				jobs[job.ID] = job.Status
+			}
+			if !maps.Equal(runners, tt.runners) {
+				t.Errorf("Expected runners %v, got %v", tt.runners, runners)
+			}
+			if !maps.Equal(jobs, tt.jobs) {
+				t.Errorf("Expected jobs %v, got %v", tt.jobs, jobs)
+			}
+		})
+	}
+}
+
+func TestData_ApplyTimestamps(t *testing.T) {
+	at := time.Date(2025, 11, 3, 10, 5, 0, 0, time.UTC)
+	data := &Data{Runners: []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusIdle}}}
+
+	data.apply(ScenarioEvent{Action: ActionJobQueued, Count: 2, Job: &entity.Job{ID: 20, Name: "test"}}, at)
+	data.apply(ScenarioEvent{Action: ActionJobStarted, JobID: 21, RunnerID: 1}, at.Add(time.Minute))
+
+	if data.Jobs[0].Name != "test #1" || data.Jobs[1].Name != "test #2" {
+		t.Errorf("Expected numbered job names, got %q and %q", data.Jobs[0].Name, data.Jobs[1].Name)
+	}
+	if !data.Jobs[0].CreatedAt.Equal(at) {
+		t.Errorf("Expected the job to be created at %v, got %v", at, data.Jobs[0].CreatedAt)
+	}
+	started := data.Jobs[1]
+	if started.StartedAt == nil || !started.StartedAt.Equal(at.Add(time.Minute)) {
+		t.Errorf("Expected the job to start at %v, got %v", at.Add(time.Minute), started.StartedAt)
+	}
+	if started.RunnerID == nil || *started.RunnerID != 1 || started.RunnerName == nil || *started.RunnerName != "runner-1" {
+		t.Errorf("Expected the job to be assigned to runner-1, got %v %v", started.RunnerID, started.RunnerName)
+	}
+}
+
+func TestData_Sync(t *testing.T) {
+	start := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := &Data{
+		CurrentTime: start,
+		startTime:   start,
+		Runners:     []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusIdle}},
+		Scenario: &Scenario{
+			Speed: 2,
+			Events: []ScenarioEvent{
+				{At: Offset(time.Minute), Action: ActionRunnerOffline, RunnerID: 1},
+				{At: Offset(30 * time.Second), Action: ActionJobQueued, Job: &entity.Job{ID: 10, Name: "build"}},
+			},
+		},
+	}
+	if err := data.Scenario.start(func() time.Time { return clock }); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	steps := []struct {
+		advance time.Duration
+		status  entity.RunnerStatus
+		jobs    int
+	}{
+		{advance: 10 * time.Second, status: entity.StatusIdle, jobs: 0},
+		{advance: 5 * time.Second, status: entity.StatusIdle, jobs: 1},
+		{advance: 15 * time.Second, status: entity.StatusOffline, jobs: 1},
+	}
+	for _, step := range steps {
+		clock = clock.Add(step.advance)
+		before := data.Runners
+		data.sync()
+
+		if data.Runners[0].Status != step.status || len(data.Jobs) != step.jobs {
+			t.Errorf("At %v: expected %s with %d jobs, got %s with %d jobs", data.CurrentTime, step.status, step.jobs, data.Runners[0].Status, len(data.Jobs))
+		}
+		if before[0] == data.Runners[0] {
+			t.Error("Expected the runners to be copied on sync")
+		}
+	}
+	if expected := start.Add(time.Minute); !data.CurrentTime.Equal(expected) {
+		t.Errorf("Expected the simulated time to be %v, got %v", expected, data.CurrentTime)
+	}
+}
+
+func TestScenario_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		event ScenarioEvent
+	}{
+		{name: "runner_offline without runner_id", event: ScenarioEvent{Action: ActionRunnerOffline}},
+		{name: "runner_added without runner", event: ScenarioEvent{Action: ActionRunnerAdded}},
+		{name: "job_queued without job", event: ScenarioEvent{Action: ActionJobQueued}},
+		{name: "job_started without runner_id", event: ScenarioEvent{Action: ActionJobStarted, JobID: 1}},
+		{name: "job_finished without job_id", event: ScenarioEvent{Action: ActionJobFinished}},
+		{name: "unknown action", event: ScenarioEvent{Action: "explode"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenario := &Scenario{Events: []ScenarioEvent{tt.event}}
+			if err := scenario.start(time.Now); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestBundledScenarios(t *testing.T) {
+	paths, err := filepath.Glob("../../../test/scenarios/*.json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("Expected bundled scenarios")
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := LoadDebugData(path)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if data.Scenario == nil || len(data.Scenario.Events) == 0 {
+				t.Error("Expected scenario events")
+			}
+		})
+	}
+}
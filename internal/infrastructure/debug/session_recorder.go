@@ -0,0 +1,111 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// Patterns of secrets that must not be written to session files
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{20,}`),
+	regexp.MustCompile(`github_pat_[A-Za-z0-9_]{20,}`),
+	regexp.MustCompile(`(?i)((?:access_token|token|sig|jwt)=)[^&"\s\\]+`),
+	regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]+`),
+}
+
+// sessionFileExt is the extension of session files, which hold one snapshot per line
+const sessionFileExt = ".jsonl"
+
+// redactedSecret replaces secrets found in recorded data
+const redactedSecret = "REDACTED"
+
+// SessionRecorder writes every snapshot to a session file as one line of debug data
+type SessionRecorder struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewSessionRecorder creates a session file in dir
+func NewSessionRecorder(dir string) (*SessionRecorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create record directory: %w", err)
+	}
+
+	name := fmt.Sprintf("session-%s%s", time.Now().Format("20060102-150405"), sessionFileExt)
+	file, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session file: %w", err)
+	}
+
+	return &SessionRecorder{
+		file: file,
+	}, nil
+}
+
+// Path returns the path of the session file
+func (r *SessionRecorder) Path() string {
+	return r.file.Name()
+}
+
+// Observe appends the snapshot to the session file with secrets redacted
+// Runners are recorded with the status the repository reported, so that replaying derives their status again
+func (r *SessionRecorder) Observe(_ context.Context, data *value_object.MonitorData) error {
+	line, err := json.Marshal(&Data{
+		RecordedAt:  time.Now(),
+		CurrentTime: data.CurrentTime,
+		Runners:     reportedRunners(data),
+		Jobs:        data.Jobs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	line = append(redactSecrets(line), '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.file.Write(line); err != nil {
+		return fmt.Errorf("failed to record snapshot: %w", err)
+	}
+	return nil
+}
+
+// Close closes the session file
+func (r *SessionRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+// reportedRunners copies the runners of the snapshot with the status the repository reported
+func reportedRunners(data *value_object.MonitorData) []*entity.Runner {
+	runners := make([]*entity.Runner, 0, len(data.Runners))
+	for _, runner := range data.Runners {
+		reported := *runner
+		if status, ok := data.ReportedStatuses[runner.ID]; ok {
+			reported.Status = status
+		}
+		runners = append(runners, &reported)
+	}
+	return runners
+}
+
+// redactSecrets replaces tokens found in the encoded snapshot
+func redactSecrets(b []byte) []byte {
+	for _, pattern := range secretPatterns {
+		if pattern.NumSubexp() > 0 {
+			b = pattern.ReplaceAll(b, []byte("${1}"+redactedSecret))
+		} else {
+			b = pattern.ReplaceAll(b, []byte(redactedSecret))
+		}
+	}
+	return b
+}
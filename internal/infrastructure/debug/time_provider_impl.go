@@ -21,5 +21,8 @@ func NewTimeProvider(data *Data) repository.TimeProvider {
 // GetCurrentTime returns the current time from the debug data
 // This allows time to be mocked in debug mode
 func (t *TimeProviderImpl) GetCurrentTime() time.Time {
+	t.data.sync()
+	t.data.mu.RLock()
+	defer t.data.mu.RUnlock()
 	return t.data.CurrentTime
 }
@@ -0,0 +1,44 @@
+package debug
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// debugTokenLifetime matches the lifetime of tokens issued by GitHub
+const debugTokenLifetime = time.Hour
+
+// TokenRepositoryImpl is a token repository implementation that returns fixed tokens
+type TokenRepositoryImpl struct {
+	data *Data
+}
+
+// NewTokenRepository creates a new debug token repository from loaded data
+func NewTokenRepository(data *Data) repository.TokenRepository {
+	return &TokenRepositoryImpl{
+		data: data,
+	}
+}
+
+// CreateRegistrationToken returns a fake registration token
+func (d *TokenRepositoryImpl) CreateRegistrationToken(_ context.Context, _ value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	return d.newToken(value_object.TokenRegistration), nil
+}
+
+// CreateRemoveToken returns a fake remove token
+func (d *TokenRepositoryImpl) CreateRemoveToken(_ context.Context, _ value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	return d.newToken(value_object.TokenRemove), nil
+}
+
+// newToken creates a token that expires relative to the debug data's current time
+func (d *TokenRepositoryImpl) newToken(kind value_object.TokenKind) *value_object.RunnerToken {
+	return &value_object.RunnerToken{
+		Kind:      kind,
+		Token:     "DEBUG" + strings.ToUpper(string(kind)) + "TOKEN",
+		ExpiresAt: d.data.CurrentTime.Add(debugTokenLifetime),
+	}
+}
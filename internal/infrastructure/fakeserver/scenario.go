@@ -0,0 +1,101 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Scenario describes the data served by the fake server and the failures it injects
+type Scenario struct {
+	RateLimit    RateLimit     `json:"rate_limit"`
+	Runners      []Runner      `json:"runners"`
+	WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+	Errors       []ErrorRule   `json:"errors"`
+}
+
+// RateLimit configures the rate-limit headers and the point at which requests are rejected
+type RateLimit struct {
+	// Limit is the number of requests allowed per window, 5000 when zero
+	Limit int `json:"limit"`
+	// Remaining is the number of requests left at startup, Limit when zero
+	Remaining int `json:"remaining"`
+}
+
+// Runner is a self-hosted runner served by the fake server
+type Runner struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	OS     string   `json:"os"`
+	Status string   `json:"status"`
+	Busy   bool     `json:"busy"`
+	Labels []string `json:"labels"`
+	// Scope is the repository (owner/repo), organization or enterprise the runner belongs to, every scope when empty
+	Scope string `json:"scope"`
+}
+
+// WorkflowRun is a workflow run served by the fake server
+type WorkflowRun struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`
+	Conclusion *string   `json:"conclusion"`
+	Repository string    `json:"repository"`
+	CreatedAt  time.Time `json:"created_at"`
+	Jobs       []Job     `json:"jobs"`
+}
+
+// Job is a job of a workflow run served by the fake server
+type Job struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  *string    `json:"conclusion"`
+	CreatedAt   *time.Time `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	RunnerID    *int64     `json:"runner_id"`
+	RunnerName  *string    `json:"runner_name"`
+	Labels      []string   `json:"labels"`
+	Steps       []Step     `json:"steps"`
+	// Log is the plain text log served for the job
+	Log string `json:"log"`
+}
+
+// Step is a step of a job served by the fake server
+type Step struct {
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  *string    `json:"conclusion"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// ErrorRule makes matching requests fail with the given status
+type ErrorRule struct {
+	// Method matches the request method, every method when empty
+	Method string `json:"method"`
+	// Path is a path.Match pattern matched against the request path without the leading slash
+	Path string `json:"path"`
+	// Status is the HTTP status code of the error response
+	Status int `json:"status"`
+	// Message is the error message in the response body
+	Message string `json:"message"`
+	// Times is the number of requests that fail, every matching request when zero
+	Times int `json:"times"`
+}
+
+// LoadScenario loads a scenario from a JSON file
+func LoadScenario(path string) (*Scenario, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+
+	var scenario Scenario
+	if err := json.Unmarshal(file, &scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+	}
+	return &scenario, nil
+}
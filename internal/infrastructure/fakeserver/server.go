@@ -0,0 +1,394 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRateLimit matches the hourly limit of authenticated GitHub requests
+	defaultRateLimit = 5000
+	// rateLimitWindow is the time until the rate limit resets
+	rateLimitWindow = time.Hour
+	// defaultPerPage and maxPerPage match the pagination limits of the GitHub API
+	defaultPerPage = 30
+	maxPerPage     = 100
+)
+
+// Labels GitHub assigns to every self-hosted runner, reported as read-only
+var defaultLabels = []string{"self-hosted", "linux", "windows", "macos", "x64", "arm", "arm64"}
+
+// Server is a fake GitHub Actions API serving the data of a scenario
+// It implements http.Handler and can be used with httptest.NewServer
+type Server struct {
+	mu       sync.Mutex
+	scenario *Scenario
+	mux      *http.ServeMux
+
+	remaining  int
+	resetAt    time.Time
+	errorCount map[int]int
+	requests   []string
+}
+
+// New creates a fake server for the scenario
+func New(scenario *Scenario) *Server {
+	s := &Server{
+		scenario:   scenario,
+		mux:        http.NewServeMux(),
+		errorCount: make(map[int]int),
+	}
+	s.resetRateLimit(time.Now())
+	if scenario.RateLimit.Remaining > 0 {
+		s.remaining = scenario.RateLimit.Remaining
+	}
+
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/actions/runners", s.handleListRunners)
+	s.mux.HandleFunc("GET /orgs/{org}/actions/runners", s.handleListRunners)
+	s.mux.HandleFunc("GET /enterprises/{enterprise}/actions/runners", s.handleListRunners)
+	s.mux.HandleFunc("DELETE /repos/{owner}/{repo}/actions/runners/{id}", s.handleDeleteRunner)
+	s.mux.HandleFunc("DELETE /orgs/{org}/actions/runners/{id}", s.handleDeleteRunner)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/actions/runs", s.handleListRuns)
+	s.mux.HandleFunc("GET /orgs/{org}/actions/runs", s.handleListRuns)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/actions/runs/{id}/jobs", s.handleListJobs)
+	s.mux.HandleFunc("POST /repos/{owner}/{repo}/actions/runs/{id}/cancel", s.handleCancelRun)
+	s.mux.HandleFunc("POST /repos/{owner}/{repo}/actions/runs/{id}/force-cancel", s.handleCancelRun)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/actions/jobs/{id}/logs", s.handleJobLogs)
+	s.mux.HandleFunc("GET /_logs/jobs/{id}", s.handleDownloadJobLog)
+	return s
+}
+
+// Requests returns the requests received so far as "METHOD /path?query"
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// ServeHTTP applies rate limiting and error injection before routing the request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.RequestURI()))
+
+	now := time.Now()
+	if now.After(s.resetAt) {
+		s.resetRateLimit(now)
+	}
+	if s.remaining == 0 {
+		s.writeRateLimitHeaders(w)
+		s.mu.Unlock()
+		writeError(w, http.StatusForbidden, "API rate limit exceeded")
+		return
+	}
+	s.remaining--
+	s.writeRateLimitHeaders(w)
+
+	rule := s.matchErrorRule(r)
+	s.mu.Unlock()
+
+	if rule != nil {
+		writeError(w, rule.Status, rule.Message)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// limit returns the configured rate limit
+func (s *Server) limit() int {
+	if s.scenario.RateLimit.Limit > 0 {
+		return s.scenario.RateLimit.Limit
+	}
+	return defaultRateLimit
+}
+
+// resetRateLimit starts a new rate-limit window
+func (s *Server) resetRateLimit(now time.Time) {
+	s.remaining = s.limit()
+	s.resetAt = now.Add(rateLimitWindow)
+}
+
+// writeRateLimitHeaders reports the rate-limit state like the GitHub API
+func (s *Server) writeRateLimitHeaders(w http.ResponseWriter) {
+	limit := s.limit()
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.remaining))
+	w.Header().Set("X-RateLimit-Used", strconv.Itoa(limit-s.remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(s.resetAt.Unix(), 10))
+	w.Header().Set("X-RateLimit-Resource", "core")
+}
+
+// matchErrorRule returns the first error rule that applies to the request and counts it
+func (s *Server) matchErrorRule(r *http.Request) *ErrorRule {
+	requestPath := strings.TrimPrefix(r.URL.Path, "/")
+	for i := range s.scenario.Errors {
+		rule := &s.scenario.Errors[i]
+		if rule.Method != "" && !strings.EqualFold(rule.Method, r.Method) {
+			continue
+		}
+		if matched, _ := path.Match(rule.Path, requestPath); !matched {
+			continue
+		}
+		if rule.Times > 0 && s.errorCount[i] >= rule.Times {
+			continue
+		}
+		s.errorCount[i]++
+		return rule
+	}
+	return nil
+}
+
+// handleListRunners serves the runners of a repository, organization or enterprise
+func (s *Server) handleListRunners(w http.ResponseWriter, r *http.Request) {
+	scope := requestScope(r)
+
+	s.mu.Lock()
+	runners := make([]runnerResponse, 0, len(s.scenario.Runners))
+	for _, runner := range s.scenario.Runners {
+		if runner.Scope == "" || runner.Scope == scope {
+			runners = append(runners, newRunnerResponse(runner))
+		}
+	}
+	s.mu.Unlock()
+
+	page := paginate(w, r, runners)
+	writeJSON(w, http.StatusOK, runnersResponse{TotalCount: len(runners), Runners: page})
+}
+
+// handleDeleteRunner removes a runner
+func (s *Server) handleDeleteRunner(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	index := slices.IndexFunc(s.scenario.Runners, func(runner Runner) bool { return runner.ID == id })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	s.scenario.Runners = slices.Delete(s.scenario.Runners, index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleListRuns serves the workflow runs of a repository or organization, filtered by status
+func (s *Server) handleListRuns(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	repository := ""
+	if r.PathValue("owner") != "" {
+		repository = r.PathValue("owner") + "/" + r.PathValue("repo")
+	}
+
+	s.mu.Lock()
+	runs := make([]workflowRunResponse, 0, len(s.scenario.WorkflowRuns))
+	for _, run := range s.scenario.WorkflowRuns {
+		if repository != "" && run.Repository != repository {
+			continue
+		}
+		if org := r.PathValue("org"); org != "" && !strings.HasPrefix(run.Repository, org+"/") {
+			continue
+		}
+		if status != "" && run.Status != status {
+			continue
+		}
+		runs = append(runs, newWorkflowRunResponse(run))
+	}
+	s.mu.Unlock()
+
+	page := paginate(w, r, runs)
+	writeJSON(w, http.StatusOK, workflowRunsResponse{TotalCount: len(runs), WorkflowRuns: page})
+}
+
+// handleListJobs serves the jobs of a workflow run
+func (s *Server) handleListJobs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	run := s.findRun(r)
+	var jobs []jobResponse
+	if run != nil {
+		jobs = make([]jobResponse, 0, len(run.Jobs))
+		for _, job := range run.Jobs {
+			jobs = append(jobs, newJobResponse(*run, job))
+		}
+	}
+	s.mu.Unlock()
+
+	if run == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	page := paginate(w, r, jobs)
+	writeJSON(w, http.StatusOK, jobsResponse{TotalCount: len(jobs), Jobs: page})
+}
+
+// handleCancelRun completes a workflow run and its unfinished jobs as cancelled
+func (s *Server) handleCancelRun(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run := s.findRun(r)
+	if run == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	if run.Status == "completed" {
+		writeError(w, http.StatusConflict, "Cannot cancel a workflow run that is completed.")
+		return
+	}
+
+	cancelled := "cancelled"
+	run.Status = "completed"
+	run.Conclusion = &cancelled
+	for i := range run.Jobs {
+		if run.Jobs[i].Status != "completed" {
+			run.Jobs[i].Status = "completed"
+			run.Jobs[i].Conclusion = &cancelled
+		}
+	}
+	writeJSON(w, http.StatusAccepted, struct{}{})
+}
+
+// handleJobLogs redirects to the download URL of a job log like the GitHub API
+func (s *Server) handleJobLogs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	job := s.findJob(r)
+	s.mu.Unlock()
+
+	if job == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	http.Redirect(w, r, fmt.Sprintf("/_logs/jobs/%d", job.ID), http.StatusFound)
+}
+
+// handleDownloadJobLog serves the plain text log of a job, standing in for the log storage
+func (s *Server) handleDownloadJobLog(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	job := s.findJob(r)
+	var log string
+	if job != nil {
+		log = job.Log
+	}
+	s.mu.Unlock()
+
+	if job == nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = io.WriteString(w, log)
+}
+
+// findJob returns the job addressed by the request, or nil if there is none
+// The repository is checked when the request addresses one
+func (s *Server) findJob(r *http.Request) *Job {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return nil
+	}
+	repository := r.PathValue("owner") + "/" + r.PathValue("repo")
+	for i := range s.scenario.WorkflowRuns {
+		run := &s.scenario.WorkflowRuns[i]
+		if r.PathValue("owner") != "" && run.Repository != repository {
+			continue
+		}
+		for j := range run.Jobs {
+			if run.Jobs[j].ID == id {
+				return &run.Jobs[j]
+			}
+		}
+	}
+	return nil
+}
+
+// findRun returns the workflow run addressed by the request, or nil if there is none
+func (s *Server) findRun(r *http.Request) *WorkflowRun {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return nil
+	}
+	repository := r.PathValue("owner") + "/" + r.PathValue("repo")
+	for i := range s.scenario.WorkflowRuns {
+		run := &s.scenario.WorkflowRuns[i]
+		if run.ID == id && run.Repository == repository {
+			return run
+		}
+	}
+	return nil
+}
+
+// requestScope returns the repository (owner/repo), organization or enterprise addressed by the request
+func requestScope(r *http.Request) string {
+	switch {
+	case r.PathValue("enterprise") != "":
+		return r.PathValue("enterprise")
+	case r.PathValue("org") != "":
+		return r.PathValue("org")
+	default:
+		return r.PathValue("owner") + "/" + r.PathValue("repo")
+	}
+}
+
+// paginate returns the requested page of items and sets the Link header like the GitHub API
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T) []T {
+	query := r.URL.Query()
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	perPage = min(perPage, maxPerPage)
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	lastPage := max((len(items)+perPage-1)/perPage, 1)
+	var links []string
+	pageURL := func(p int) string {
+		u := *r.URL
+		u.Scheme = "http"
+		u.Host = r.Host
+		q := u.Query()
+		q.Set("per_page", strconv.Itoa(perPage))
+		q.Set("page", strconv.Itoa(p))
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+	if page < lastPage {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(page+1)), fmt.Sprintf(`<%s>; rel="last"`, pageURL(lastPage)))
+	}
+	if page > 1 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, pageURL(page-1)), fmt.Sprintf(`<%s>; rel="first"`, pageURL(1)))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	return items[start:end]
+}
+
+// writeJSON writes the value as a JSON response
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the format of the GitHub API
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{
+		Message:          message,
+		DocumentationURL: "https://docs.github.com/rest",
+	})
+}
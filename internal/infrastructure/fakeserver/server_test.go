@@ -0,0 +1,179 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newRunners creates count online runners visible in every scope
+func newRunners(count int) []Runner {
+	runners := make([]Runner, 0, count)
+	for i := range count {
+		runners = append(runners, Runner{ID: int64(i + 1), Name: fmt.Sprintf("runner-%d", i+1), Status: "online"})
+	}
+	return runners
+}
+
+// get sends a GET request to the server and returns the recorded response
+func get(server *Server, target string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	return recorder
+}
+
+func TestServer_Paginate(t *testing.T) {
+	server := New(&Scenario{Runners: newRunners(75)})
+
+	tests := []struct {
+		name      string
+		query     string
+		expected  int
+		firstID   int64
+		links     []string
+		noNextRel bool
+	}{
+		{
+			name:     "defaults to 30 per page",
+			expected: 30,
+			firstID:  1,
+			links:    []string{`page=2&per_page=30>; rel="next"`, `page=3&per_page=30>; rel="last"`},
+		},
+		{
+			name:      "last page",
+			query:     "?per_page=50&page=2",
+			expected:  25,
+			firstID:   51,
+			links:     []string{`page=1&per_page=50>; rel="prev"`, `page=1&per_page=50>; rel="first"`},
+			noNextRel: true,
+		},
+		{
+			name:      "caps per_page at 100",
+			query:     "?per_page=500",
+			expected:  75,
+			firstID:   1,
+			noNextRel: true,
+		},
+		{
+			name:      "page past the end",
+			query:     "?per_page=50&page=5",
+			expected:  0,
+			noNextRel: true,
+		},
+		{
+			name:     "invalid values fall back to the defaults",
+			query:    "?per_page=abc&page=0",
+			expected: 30,
+			firstID:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := get(server, "/orgs/my-org/actions/runners"+tt.query)
+
+			if response.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", response.Code)
+			}
+			var body runnersResponse
+			if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if body.TotalCount != 75 || len(body.Runners) != tt.expected {
+				t.Fatalf("Expected %d of 75 runners, got %d of %d", tt.expected, len(body.Runners), body.TotalCount)
+			}
+			if tt.expected > 0 && body.Runners[0].ID != tt.firstID {
+				t.Errorf("Expected the page to start at runner %d, got %d", tt.firstID, body.Runners[0].ID)
+			}
+			link := response.Header().Get("Link")
+			for _, expected := range tt.links {
+				if !strings.Contains(link, expected) {
+					t.Errorf("Expected %q in the Link header, got %q", expected, link)
+				}
+			}
+			if tt.noNextRel && strings.Contains(link, `rel="next"`) {
+				t.Errorf("Expected no next page, got %q", link)
+			}
+		})
+	}
+}
+
+func TestServer_ErrorRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     ErrorRule
+		method   string
+		target   string
+		expected []int
+	}{
+		{
+			name:     "fails matching requests every time",
+			rule:     ErrorRule{Path: "repos/*/*/actions/runners", Status: 500, Message: "Server Error"},
+			method:   http.MethodGet,
+			target:   "/repos/owner/repo/actions/runners",
+			expected: []int{500, 500, 500},
+		},
+		{
+			name:     "fails only the configured number of times",
+			rule:     ErrorRule{Path: "orgs/*/actions/runners", Status: 502, Times: 2},
+			method:   http.MethodGet,
+			target:   "/orgs/my-org/actions/runners?per_page=100",
+			expected: []int{502, 502, 200},
+		},
+		{
+			name:     "ignores other methods",
+			rule:     ErrorRule{Method: "DELETE", Path: "repos/*/*/actions/runners", Status: 500},
+			method:   http.MethodGet,
+			target:   "/repos/owner/repo/actions/runners",
+			expected: []int{200, 200},
+		},
+		{
+			name:     "ignores other paths",
+			rule:     ErrorRule{Path: "repos/*/*/actions/runs", Status: 500},
+			method:   http.MethodGet,
+			target:   "/repos/owner/repo/actions/runners",
+			expected: []int{200},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := New(&Scenario{Runners: newRunners(1), Errors: []ErrorRule{tt.rule}})
+
+			for i, expected := range tt.expected {
+				recorder := httptest.NewRecorder()
+				server.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.target, nil))
+				if recorder.Code != expected {
+					t.Errorf("Request %d: expected status %d, got %d", i+1, expected, recorder.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	server := New(&Scenario{RateLimit: RateLimit{Limit: 10, Remaining: 2}})
+
+	for i, expected := range []int{200, 200, 403} {
+		response := get(server, "/repos/owner/repo/actions/runners")
+		if response.Code != expected {
+			t.Errorf("Request %d: expected status %d, got %d", i+1, expected, response.Code)
+		}
+	}
+	if remaining := get(server, "/repos/owner/repo/actions/runners").Header().Get("X-RateLimit-Remaining"); remaining != "0" {
+		t.Errorf("Expected no remaining requests, got %q", remaining)
+	}
+}
+
+func TestLoadScenario(t *testing.T) {
+	scenario, err := LoadScenario("../../../test/fake_server_scenario.json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(scenario.Runners) == 0 {
+		t.Error("Expected runners in the bundled scenario")
+	}
+}
@@ -0,0 +1,153 @@
+package fakeserver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Response types in the format of the GitHub API
+type runnersResponse struct {
+	TotalCount int              `json:"total_count"`
+	Runners    []runnerResponse `json:"runners"`
+}
+
+type runnerResponse struct {
+	ID     int64           `json:"id"`
+	Name   string          `json:"name"`
+	OS     string          `json:"os"`
+	Status string          `json:"status"`
+	Busy   bool            `json:"busy"`
+	Labels []labelResponse `json:"labels"`
+}
+
+type labelResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type workflowRunsResponse struct {
+	TotalCount   int                   `json:"total_count"`
+	WorkflowRuns []workflowRunResponse `json:"workflow_runs"`
+}
+
+type workflowRunResponse struct {
+	ID         int64              `json:"id"`
+	Name       string             `json:"name"`
+	Status     string             `json:"status"`
+	Conclusion *string            `json:"conclusion"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+	Repository repositoryResponse `json:"repository"`
+}
+
+type repositoryResponse struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+}
+
+type jobsResponse struct {
+	TotalCount int           `json:"total_count"`
+	Jobs       []jobResponse `json:"jobs"`
+}
+
+type jobResponse struct {
+	ID          int64          `json:"id"`
+	RunID       int64          `json:"run_id"`
+	Name        string         `json:"name"`
+	Status      string         `json:"status"`
+	Conclusion  *string        `json:"conclusion"`
+	CreatedAt   *time.Time     `json:"created_at"`
+	StartedAt   *time.Time     `json:"started_at"`
+	CompletedAt *time.Time     `json:"completed_at"`
+	RunnerID    *int64         `json:"runner_id"`
+	RunnerName  *string        `json:"runner_name"`
+	Labels      []string       `json:"labels"`
+	HtmlUrl     string         `json:"html_url"`
+	Steps       []stepResponse `json:"steps"`
+}
+
+type stepResponse struct {
+	Number      int        `json:"number"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  *string    `json:"conclusion"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+type errorResponse struct {
+	Message          string `json:"message"`
+	DocumentationURL string `json:"documentation_url"`
+}
+
+// newRunnerResponse converts a scenario runner to the API format
+func newRunnerResponse(runner Runner) runnerResponse {
+	labels := make([]labelResponse, 0, len(runner.Labels))
+	for i, name := range runner.Labels {
+		labelType := "custom"
+		if slices.Contains(defaultLabels, name) {
+			labelType = "read-only"
+		}
+		labels = append(labels, labelResponse{ID: int64(i + 1), Name: name, Type: labelType})
+	}
+	return runnerResponse{
+		ID:     runner.ID,
+		Name:   runner.Name,
+		OS:     runner.OS,
+		Status: runner.Status,
+		Busy:   runner.Busy,
+		Labels: labels,
+	}
+}
+
+// newWorkflowRunResponse converts a scenario workflow run to the API format
+func newWorkflowRunResponse(run WorkflowRun) workflowRunResponse {
+	_, name, _ := strings.Cut(run.Repository, "/")
+	return workflowRunResponse{
+		ID:         run.ID,
+		Name:       run.Name,
+		Status:     run.Status,
+		Conclusion: run.Conclusion,
+		CreatedAt:  run.CreatedAt,
+		UpdatedAt:  run.CreatedAt,
+		Repository: repositoryResponse{Name: name, FullName: run.Repository},
+	}
+}
+
+// newJobResponse converts a scenario job of the run to the API format
+func newJobResponse(run WorkflowRun, job Job) jobResponse {
+	return jobResponse{
+		ID:          job.ID,
+		RunID:       run.ID,
+		Name:        job.Name,
+		Status:      job.Status,
+		Conclusion:  job.Conclusion,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+		RunnerID:    job.RunnerID,
+		RunnerName:  job.RunnerName,
+		Labels:      job.Labels,
+		HtmlUrl:     fmt.Sprintf("https://github.com/%s/actions/runs/%d/job/%d", run.Repository, run.ID, job.ID),
+		Steps:       newStepResponses(job.Steps),
+	}
+}
+
+// newStepResponses converts the scenario steps of a job to the API format, numbered from 1
+func newStepResponses(steps []Step) []stepResponse {
+	responses := make([]stepResponse, 0, len(steps))
+	for i, step := range steps {
+		responses = append(responses, stepResponse{
+			Number:      i + 1,
+			Name:        step.Name,
+			Status:      step.Status,
+			Conclusion:  step.Conclusion,
+			StartedAt:   step.StartedAt,
+			CompletedAt: step.CompletedAt,
+		})
+	}
+	return responses
+}
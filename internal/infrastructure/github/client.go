@@ -0,0 +1,50 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// apiHost is the host the REST client is created for when a custom API URL is used
+// go-gh sends its requests for this host to http://api.github.localhost/
+const apiHost = "github.localhost"
+
+// NewRESTClient creates a REST client that sends every request to the API at baseURL
+// This is used to point the repositories at a fake server
+func NewRESTClient(baseURL string) (*api.RESTClient, error) {
+	target, err := url.Parse(baseURL)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return nil, fmt.Errorf("invalid API URL %q", baseURL)
+	}
+
+	restClient, err := api.NewRESTClient(api.ClientOptions{
+		Host:         apiHost,
+		AuthToken:    "unused",
+		LogIgnoreEnv: true,
+		Transport:    &rewriteTransport{target: target, base: http.DefaultTransport},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create REST client: %w", err)
+	}
+	return restClient, nil
+}
+
+// rewriteTransport redirects requests to the target URL, keeping their path and query
+type rewriteTransport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+// RoundTrip sends the request to the target
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	req.URL.Path = strings.TrimSuffix(t.target.Path, "/") + req.URL.Path
+	req.Host = t.target.Host
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	domainrepo "github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// DownloadRepositoryImpl implements the DownloadRepository interface using GitHub API
+type DownloadRepositoryImpl struct {
+	restClient *api.RESTClient
+}
+
+// NewDownloadRepository creates a new instance of DownloadRepositoryImpl
+func NewDownloadRepository() (domainrepo.DownloadRepository, error) {
+	restClient, err := api.DefaultRESTClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create REST client: %w\nPlease run 'gh auth login' to authenticate with GitHub", err)
+	}
+
+	return &DownloadRepositoryImpl{
+		restClient: restClient,
+	}, nil
+}
+
+// FetchRunnerDownloads returns the runner application packages available to the scope
+func (d *DownloadRepositoryImpl) FetchRunnerDownloads(ctx context.Context, scope value_object.RunnerScope) ([]*value_object.RunnerDownload, error) {
+	path := fmt.Sprintf("%s/actions/runners/downloads", getScopePath(scope))
+	response, err := d.restClient.RequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request runner downloads: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	var downloads []runnerDownloadResponse
+	if err := json.NewDecoder(response.Body).Decode(&downloads); err != nil {
+		return nil, fmt.Errorf("failed to decode runner downloads response: %w", err)
+	}
+
+	result := make([]*value_object.RunnerDownload, 0, len(downloads))
+	for _, download := range downloads {
+		result = append(result, &value_object.RunnerDownload{
+			OS:             download.OS,
+			Architecture:   download.Architecture,
+			DownloadURL:    download.DownloadURL,
+			Filename:       download.Filename,
+			SHA256Checksum: download.SHA256Checksum,
+		})
+	}
+	return result, nil
+}
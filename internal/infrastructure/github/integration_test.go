@@ -0,0 +1,350 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	domainrepo "github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/fakeserver"
+)
+
+// newFakeServer starts a fake server for the scenario and returns repositories pointed at it
+func newFakeServer(t *testing.T, scenario *fakeserver.Scenario) (*fakeserver.Server, *RunnerRepositoryImpl, *JobRepositoryImpl) {
+	t.Helper()
+
+	server := fakeserver.New(scenario)
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	restClient, err := NewRESTClient(httpServer.URL)
+	if err != nil {
+		t.Fatalf("Failed to create REST client: %v", err)
+	}
+	return server, &RunnerRepositoryImpl{restClient: restClient}, &JobRepositoryImpl{restClient: restClient}
+}
+
+// newRuns creates count workflow runs of the repository with one job each
+func newRuns(repository, status string, firstID int64, count int) []fakeserver.WorkflowRun {
+	startedAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+	runs := make([]fakeserver.WorkflowRun, 0, count)
+	for i := range count {
+		id := firstID + int64(i)
+		runs = append(runs, fakeserver.WorkflowRun{
+			ID:         id,
+			Name:       "CI",
+			Status:     status,
+			Repository: repository,
+			CreatedAt:  startedAt,
+			Jobs: []fakeserver.Job{
+				{ID: id * 10, Name: fmt.Sprintf("build-%d", id), Status: status, StartedAt: &startedAt, Labels: []string{"self-hosted"}},
+			},
+		})
+	}
+	return runs
+}
+
+func TestRunnerRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("decodes runners and their status", func(t *testing.T) {
+		_, runnerRepo, _ := newFakeServer(t, &fakeserver.Scenario{
+			Runners: []fakeserver.Runner{
+				{ID: 1, Name: "busy", OS: "Linux", Status: "online", Busy: true, Labels: []string{"self-hosted", "gpu"}},
+				{ID: 2, Name: "idle", OS: "Linux", Status: "online"},
+				{ID: 3, Name: "offline", OS: "macOS", Status: "offline"},
+				{ID: 4, Name: "other-repo", Status: "online", Scope: "owner/other"},
+			},
+		})
+
+		runners, err := runnerRepo.FetchRunners(ctx, "owner", "repo", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []entity.RunnerStatus{entity.StatusActive, entity.StatusIdle, entity.StatusOffline}
+		if len(runners) != len(expected) {
+			t.Fatalf("Expected %d runners, got %d", len(expected), len(runners))
+		}
+		for i, status := range expected {
+			if runners[i].Status != status {
+				t.Errorf("Expected %s to be %s, got %s", runners[i].Name, status, runners[i].Status)
+			}
+		}
+		if len(runners[0].Labels) != 2 || runners[0].Labels[1] != "gpu" {
+			t.Errorf("Unexpected labels %v", runners[0].Labels)
+		}
+	})
+
+	t.Run("follows pagination of runners", func(t *testing.T) {
+		runners := make([]fakeserver.Runner, 0, 150)
+		for i := range 150 {
+			runners = append(runners, fakeserver.Runner{ID: int64(i + 1), Name: fmt.Sprintf("runner-%d", i+1), Status: "online"})
+		}
+		server, runnerRepo, _ := newFakeServer(t, &fakeserver.Scenario{Runners: runners})
+
+		fetched, err := runnerRepo.FetchRunners(ctx, "", "", "my-org")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(fetched) != 150 {
+			t.Fatalf("Expected 150 runners, got %d", len(fetched))
+		}
+		if fetched[149].Name != "runner-150" {
+			t.Errorf("Expected the last runner to be runner-150, got %s", fetched[149].Name)
+		}
+		pages := 0
+		for _, request := range server.Requests() {
+			if strings.Contains(request, "actions/runners") {
+				pages++
+			}
+		}
+		if pages != 2 {
+			t.Errorf("Expected 2 pages of runners, got %d", pages)
+		}
+	})
+
+	t.Run("reports injected errors", func(t *testing.T) {
+		_, runnerRepo, _ := newFakeServer(t, &fakeserver.Scenario{
+			Errors: []fakeserver.ErrorRule{
+				{Method: "GET", Path: "orgs/*/actions/runners", Status: 502, Message: "Server Error", Times: 1},
+			},
+		})
+
+		if _, err := runnerRepo.FetchRunners(ctx, "", "", "my-org"); err == nil || !strings.Contains(err.Error(), "502") {
+			t.Errorf("Expected a 502 error, got %v", err)
+		}
+		if _, err := runnerRepo.FetchRunners(ctx, "", "", "my-org"); err != nil {
+			t.Errorf("Expected the error to be injected once, got %v", err)
+		}
+	})
+
+	t.Run("fails once the rate limit is exhausted", func(t *testing.T) {
+		_, runnerRepo, _ := newFakeServer(t, &fakeserver.Scenario{
+			RateLimit: fakeserver.RateLimit{Limit: 100, Remaining: 1},
+		})
+
+		if _, err := runnerRepo.FetchRunners(ctx, "owner", "repo", ""); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := runnerRepo.FetchRunners(ctx, "owner", "repo", ""); err == nil || !strings.Contains(err.Error(), "rate limit") {
+			t.Errorf("Expected a rate limit error, got %v", err)
+		}
+	})
+
+	t.Run("deletes runners", func(t *testing.T) {
+		_, runnerRepo, _ := newFakeServer(t, &fakeserver.Scenario{
+			Runners: []fakeserver.Runner{{ID: 1, Name: "runner-1", Status: "offline"}},
+		})
+
+		if err := runnerRepo.DeleteRunner(ctx, "owner", "repo", "", 1); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		runners, err := runnerRepo.FetchRunners(ctx, "owner", "repo", "")
+		if err != nil || len(runners) != 0 {
+			t.Errorf("Expected no runners, got %v (%v)", runners, err)
+		}
+	})
+}
+
+func TestJobRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("follows pagination of workflow runs", func(t *testing.T) {
+		runs := newRuns("owner/repo", "in_progress", 1, 150)
+		runs = append(runs, newRuns("owner/repo", "queued", 1001, 3)...)
+		runs = append(runs, newRuns("owner/repo", "completed", 2001, 5)...)
+		server, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{WorkflowRuns: runs})
+
+		jobs, err := jobRepo.FetchActiveJobs(ctx, "owner", "repo", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(jobs) != 153 {
+			t.Errorf("Expected 153 active jobs, got %d", len(jobs))
+		}
+		pages := 0
+		for _, request := range server.Requests() {
+			if strings.Contains(request, "status=in_progress") {
+				pages++
+			}
+		}
+		if pages != 2 {
+			t.Errorf("Expected 2 pages of in_progress runs, got %d", pages)
+		}
+	})
+
+	t.Run("follows pagination of the jobs of a run", func(t *testing.T) {
+		runs := newRuns("owner/repo", "in_progress", 1, 1)
+		matrix := runs[0].Jobs[0]
+		runs[0].Jobs = nil
+		for i := range 250 {
+			job := matrix
+			job.ID = int64(1000 + i)
+			job.Name = fmt.Sprintf("matrix (%d)", i)
+			runs[0].Jobs = append(runs[0].Jobs, job)
+		}
+		server, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{WorkflowRuns: runs})
+
+		jobs, err := jobRepo.FetchActiveJobs(ctx, "owner", "repo", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(jobs) != 250 {
+			t.Fatalf("Expected 250 jobs, got %d", len(jobs))
+		}
+		if jobs[249].Name != "matrix (249)" {
+			t.Errorf("Expected the last job of the matrix, got %s", jobs[249].Name)
+		}
+		pages := 0
+		for _, request := range server.Requests() {
+			if strings.Contains(request, "runs/1/jobs") {
+				pages++
+			}
+		}
+		if pages != 3 {
+			t.Errorf("Expected 3 pages of jobs, got %d", pages)
+		}
+	})
+
+	t.Run("decodes the steps of a job", func(t *testing.T) {
+		runs := newRuns("owner/repo", "in_progress", 1, 1)
+		success := "success"
+		startedAt := runs[0].CreatedAt
+		completedAt := startedAt.Add(time.Minute)
+		runs[0].Jobs[0].Steps = []fakeserver.Step{
+			{Name: "Set up job", Status: "completed", Conclusion: &success, StartedAt: &startedAt, CompletedAt: &completedAt},
+			{Name: "Run tests", Status: "in_progress", StartedAt: &completedAt},
+		}
+		_, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{WorkflowRuns: runs})
+
+		jobs, err := jobRepo.FetchActiveJobs(ctx, "owner", "repo", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(jobs) != 1 || len(jobs[0].Steps) != 2 {
+			t.Fatalf("Expected 1 job with 2 steps, got %v", jobs)
+		}
+		first, second := jobs[0].Steps[0], jobs[0].Steps[1]
+		if first.Number != 1 || first.Conclusion != "success" || first.GetDurationAt(completedAt) != time.Minute {
+			t.Errorf("Expected a completed first step, got %+v", first)
+		}
+		if second.Number != 2 || second.Name != "Run tests" || !second.IsRunning() || second.Conclusion != "" {
+			t.Errorf("Expected the running second step, got %+v", second)
+		}
+	})
+
+	t.Run("downloads job logs through the redirect", func(t *testing.T) {
+		runs := newRuns("owner/repo", "in_progress", 1, 1)
+		runs[0].Jobs[0].Log = "##[group]Run tests\nok\n"
+		server, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{WorkflowRuns: runs})
+
+		log, err := jobRepo.FetchJobLog(ctx, "owner", "repo", 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if log != "##[group]Run tests\nok\n" {
+			t.Errorf("Expected the job log, got %q", log)
+		}
+		if requests := server.Requests(); len(requests) != 2 || requests[1] != "GET /_logs/jobs/10" {
+			t.Errorf("Expected the log to be downloaded from the redirect, got %v", requests)
+		}
+		if _, err := jobRepo.FetchJobLog(ctx, "owner", "repo", 99); err == nil {
+			t.Error("Expected an error for an unknown job")
+		}
+	})
+
+	t.Run("keeps only the end of large job logs", func(t *testing.T) {
+		var log strings.Builder
+		lines := 0
+		for log.Len() <= 3*maxJobLogSize {
+			lines++
+			fmt.Fprintf(&log, "line %d\n", lines)
+		}
+		runs := newRuns("owner/repo", "in_progress", 1, 1)
+		runs[0].Jobs[0].Log = log.String()
+		_, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{WorkflowRuns: runs})
+
+		tail, err := jobRepo.FetchJobLog(ctx, "owner", "repo", 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(tail) > len(truncatedJobLogNote)+maxJobLogSize {
+			t.Errorf("Expected at most %d bytes of the log, got %d", maxJobLogSize, len(tail))
+		}
+		if !strings.HasPrefix(tail, truncatedJobLogNote+"line ") {
+			t.Errorf("Expected the note followed by a whole line, got %q", tail[:min(len(tail), 80)])
+		}
+		if last := fmt.Sprintf("line %d\n", lines); !strings.HasSuffix(tail, last) {
+			t.Errorf("Expected the log to end with %q", last)
+		}
+	})
+
+	t.Run("maps organization runs to their repositories", func(t *testing.T) {
+		runs := newRuns("my-org/api", "in_progress", 1, 1)
+		runs = append(runs, newRuns("my-org/web", "queued", 2, 1)...)
+		runs = append(runs, newRuns("other/app", "queued", 3, 1)...)
+		_, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{WorkflowRuns: runs})
+
+		jobs, err := jobRepo.FetchActiveJobs(ctx, "", "", "my-org")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(jobs) != 2 {
+			t.Fatalf("Expected 2 jobs, got %d", len(jobs))
+		}
+		if jobs[0].Repository != "my-org/api" || jobs[1].Repository != "my-org/web" {
+			t.Errorf("Unexpected repositories %s, %s", jobs[0].Repository, jobs[1].Repository)
+		}
+		if jobs[0].RunID != 1 || jobs[0].StartedAt == nil || jobs[0].WorkflowName != "CI" {
+			t.Errorf("Unexpected job %+v", jobs[0])
+		}
+	})
+
+	t.Run("reports runs whose jobs cannot be fetched", func(t *testing.T) {
+		_, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{
+			WorkflowRuns: newRuns("owner/repo", "in_progress", 1, 2),
+			Errors: []fakeserver.ErrorRule{
+				{Path: "repos/owner/repo/actions/runs/1/jobs", Status: 500, Message: "Server Error"},
+			},
+		})
+
+		jobs, err := jobRepo.FetchActiveJobs(ctx, "owner", "repo", "")
+		var incomplete *domainrepo.IncompleteJobsError
+		if !errors.As(err, &incomplete) || len(incomplete.Errs) != 1 {
+			t.Fatalf("Expected an incomplete result for run 1, got %v", err)
+		}
+		if len(jobs) != 1 || jobs[0].RunID != 2 {
+			t.Errorf("Expected only the job of run 2, got %v", jobs)
+		}
+	})
+
+	t.Run("cancelled runs are no longer active", func(t *testing.T) {
+		_, _, jobRepo := newFakeServer(t, &fakeserver.Scenario{
+			WorkflowRuns: newRuns("owner/repo", "in_progress", 1, 1),
+		})
+
+		if err := jobRepo.CancelWorkflowRun(ctx, "owner", "repo", 1); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		jobs, err := jobRepo.FetchActiveJobs(ctx, "owner", "repo", "")
+		if err != nil || len(jobs) != 0 {
+			t.Errorf("Expected no active jobs, got %v (%v)", jobs, err)
+		}
+		if err := jobRepo.CancelWorkflowRun(ctx, "owner", "repo", 1); err == nil {
+			t.Error("Expected cancelling a completed run to fail")
+		}
+	})
+}
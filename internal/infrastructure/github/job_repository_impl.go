@@ -1,9 +1,11 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -12,6 +14,17 @@ import (
 	"github.com/cli/go-gh/v2/pkg/api"
 )
 
+// Logs of long jobs can reach hundreds of megabytes, only their end is kept
+const (
+	// maxJobLogSize is how much of the end of a job log is kept
+	maxJobLogSize = 1 << 20
+	// maxJobLogDownload bounds how much of a job log is read
+	maxJobLogDownload = 64 << 20
+)
+
+// truncatedJobLogNote replaces the lines dropped from the beginning of a job log
+const truncatedJobLogNote = "[earlier lines of the log were omitted]\n"
+
 // JobRepositoryImpl implements the JobRepository interface using GitHub API
 type JobRepositoryImpl struct {
 	restClient *api.RESTClient
@@ -29,6 +42,13 @@ func NewJobRepository() (domainrepo.JobRepository, error) {
 	}, nil
 }
 
+// NewJobRepositoryWithClient creates a new instance of JobRepositoryImpl that uses the REST client
+func NewJobRepositoryWithClient(restClient *api.RESTClient) domainrepo.JobRepository {
+	return &JobRepositoryImpl{
+		restClient: restClient,
+	}
+}
+
 // FetchActiveJobs retrieves all active jobs for a repository or organization
 func (j *JobRepositoryImpl) FetchActiveJobs(ctx context.Context, owner, repo, org string) ([]*entity.Job, error) {
 	var allJobs []*entity.Job
@@ -40,10 +60,13 @@ func (j *JobRepositoryImpl) FetchActiveJobs(ctx context.Context, owner, repo, or
 		return nil, fmt.Errorf("failed to fetch in_progress runs: %w", err)
 	}
 
+	// A run whose jobs cannot be fetched is skipped and reported, so that the other jobs are still shown
+	var errs []error
 	for _, run := range inProgressRuns.WorkflowRuns {
 		jobs, err := j.getJobsForRun(run, org, owner, repo)
 		if err != nil {
-			continue // Skip this run if we can't get jobs
+			errs = append(errs, fmt.Errorf("failed to fetch jobs of run %d: %w", run.ID, err))
+			continue
 		}
 		allJobs = append(allJobs, jobs...)
 	}
@@ -58,14 +81,120 @@ func (j *JobRepositoryImpl) FetchActiveJobs(ctx context.Context, owner, repo, or
 	for _, run := range queuedRuns.WorkflowRuns {
 		jobs, err := j.getJobsForRun(run, org, owner, repo)
 		if err != nil {
-			continue // Skip this run if we can't get jobs
+			errs = append(errs, fmt.Errorf("failed to fetch jobs of run %d: %w", run.ID, err))
+			continue
 		}
 		allJobs = append(allJobs, jobs...)
 	}
 
+	if len(errs) > 0 {
+		return allJobs, &domainrepo.IncompleteJobsError{Errs: errs}
+	}
 	return allJobs, nil
 }
 
+// CancelWorkflowRun requests cancellation of a workflow run
+func (j *JobRepositoryImpl) CancelWorkflowRun(ctx context.Context, owner, repo string, runID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/actions/runs/%d/cancel", owner, repo, runID)
+	if err := j.requestPost(ctx, path); err != nil {
+		return fmt.Errorf("failed to cancel workflow run: %w", err)
+	}
+	return nil
+}
+
+// ForceCancelWorkflowRun cancels a workflow run, bypassing conditions such as always()
+func (j *JobRepositoryImpl) ForceCancelWorkflowRun(ctx context.Context, owner, repo string, runID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/actions/runs/%d/force-cancel", owner, repo, runID)
+	if err := j.requestPost(ctx, path); err != nil {
+		return fmt.Errorf("failed to force-cancel workflow run: %w", err)
+	}
+	return nil
+}
+
+// RerunFailedJobs re-runs the failed jobs of a workflow run
+func (j *JobRepositoryImpl) RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/actions/runs/%d/rerun-failed-jobs", owner, repo, runID)
+	if err := j.requestPost(ctx, path); err != nil {
+		return fmt.Errorf("failed to re-run failed jobs: %w", err)
+	}
+	return nil
+}
+
+// RerunJob re-runs a single job
+func (j *JobRepositoryImpl) RerunJob(ctx context.Context, owner, repo string, jobID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/actions/jobs/%d/rerun", owner, repo, jobID)
+	if err := j.requestPost(ctx, path); err != nil {
+		return fmt.Errorf("failed to re-run job: %w", err)
+	}
+	return nil
+}
+
+// FetchJobLog downloads the log of a job as plain text
+// The API redirects to a short-lived download URL, which the client follows
+// Only the end of a large log is returned, with a note in place of the dropped lines
+func (j *JobRepositoryImpl) FetchJobLog(ctx context.Context, owner, repo string, jobID int64) (string, error) {
+	path := fmt.Sprintf("repos/%s/%s/actions/jobs/%d/logs", owner, repo, jobID)
+	response, err := j.restClient.RequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch job log: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	log, truncated, err := readTail(io.LimitReader(response.Body, maxJobLogDownload), maxJobLogSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to read job log: %w", err)
+	}
+	if truncated {
+		return truncatedJobLogNote + string(log), nil
+	}
+	return string(log), nil
+}
+
+// readTail reads r to the end and returns at most the last size bytes, starting at a line
+// truncated is set when the beginning was dropped
+func readTail(r io.Reader, size int) (tail []byte, truncated bool, err error) {
+	buf := make([]byte, 0, 32*1024)
+	chunk := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(chunk)
+		buf = append(buf, chunk[:n]...)
+		if len(buf) > 2*size {
+			// Compact from time to time instead of on every read
+			buf = append(buf[:0], buf[len(buf)-size:]...)
+			truncated = true
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if len(buf) > size {
+		buf = buf[len(buf)-size:]
+		truncated = true
+	}
+	if truncated {
+		// Drop the partial first line
+		if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+			buf = buf[i+1:]
+		}
+	}
+	return buf, truncated, nil
+}
+
+// requestPost sends a POST request without a body and discards the response
+func (j *JobRepositoryImpl) requestPost(ctx context.Context, path string) error {
+	response, err := j.restClient.RequestWithContext(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
 // getWorkflowRunsPath constructs the API path for fetching workflow runs with a specific status
 func (j *JobRepositoryImpl) getWorkflowRunsPath(owner, repo, org, status string) string {
 	if org != "" {
@@ -119,22 +248,42 @@ func (j *JobRepositoryImpl) fetchWorkflowRuns(path string) (*workflowRunsRespons
 	return allRuns, nil
 }
 
-// requestGetJobs fetches jobs from GitHub API
+// requestGetJobs fetches the jobs of a workflow run from GitHub API with pagination support
+// A run can have hundreds of jobs, such as a large matrix, so pages are requested until total_count is reached
 func (j *JobRepositoryImpl) requestGetJobs(path string) (*jobsResponse, error) {
-	response, err := j.restClient.Request(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to request jobs: %w", err)
+	allJobs := &jobsResponse{
+		Jobs: []jobResponse{},
 	}
-	defer func() {
+
+	page := 1
+	perPage := 100
+
+	for {
+		currentPath := fmt.Sprintf("%s?per_page=%d&page=%d", path, perPage, page)
+		response, err := j.restClient.Request(http.MethodGet, currentPath, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request jobs: %w", err)
+		}
+
+		var jobs jobsResponse
+		if err := json.NewDecoder(response.Body).Decode(&jobs); err != nil {
+			_ = response.Body.Close()
+			return nil, fmt.Errorf("failed to decode jobs response: %w", err)
+		}
 		_ = response.Body.Close()
-	}()
 
-	var jobs jobsResponse
-	if err := json.NewDecoder(response.Body).Decode(&jobs); err != nil {
-		return nil, fmt.Errorf("failed to decode jobs response: %w", err)
+		allJobs.TotalCount = jobs.TotalCount
+		allJobs.Jobs = append(allJobs.Jobs, jobs.Jobs...)
+
+		// Stop at total_count, or at an empty page in case jobs disappeared while paging
+		if len(allJobs.Jobs) >= jobs.TotalCount || len(jobs.Jobs) == 0 {
+			break
+		}
+
+		page++
 	}
 
-	return &jobs, nil
+	return allJobs, nil
 }
 
 // getJobsForRun fetches and converts jobs for a specific workflow run
@@ -161,9 +310,12 @@ func (j *JobRepositoryImpl) getJobsForRun(run workflowRun, org, owner, repo stri
 				RunnerID:     job.RunnerID,
 				RunnerName:   job.RunnerName,
 				StartedAt:    job.StartedAt,
+				CreatedAt:    job.CreatedAt,
+				Labels:       job.Labels,
 				WorkflowName: run.Name,
 				Repository:   run.Repository.FullName,
 				HtmlUrl:      job.HtmlUrl,
+				Steps:        toJobSteps(job.Steps),
 			})
 		}
 	}
@@ -171,6 +323,29 @@ func (j *JobRepositoryImpl) getJobsForRun(run workflowRun, org, owner, repo stri
 	return result, nil
 }
 
+// toJobSteps converts the steps of a job response to entities
+func toJobSteps(steps []stepResponse) []*entity.JobStep {
+	if len(steps) == 0 {
+		return nil
+	}
+	result := make([]*entity.JobStep, 0, len(steps))
+	for _, step := range steps {
+		conclusion := ""
+		if step.Conclusion != nil {
+			conclusion = *step.Conclusion
+		}
+		result = append(result, &entity.JobStep{
+			Number:      step.Number,
+			Name:        step.Name,
+			Status:      step.Status,
+			Conclusion:  conclusion,
+			StartedAt:   step.StartedAt,
+			CompletedAt: step.CompletedAt,
+		})
+	}
+	return result
+}
+
 // extractOwnerAndRepo extracts owner and repo from either org context or direct parameters
 func (j *JobRepositoryImpl) extractOwnerAndRepo(org, owner, repo, fullName string) (string, string, error) {
 	if org != "" {
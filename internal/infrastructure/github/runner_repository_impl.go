@@ -1,10 +1,13 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
@@ -29,6 +32,13 @@ func NewRunnerRepository() (domainrepo.RunnerRepository, error) {
 	}, nil
 }
 
+// NewRunnerRepositoryWithClient creates a new instance of RunnerRepositoryImpl that uses the REST client
+func NewRunnerRepositoryWithClient(restClient *api.RESTClient) domainrepo.RunnerRepository {
+	return &RunnerRepositoryImpl{
+		restClient: restClient,
+	}
+}
+
 // FetchRunners retrieves all runners for a repository or organization
 func (r *RunnerRepositoryImpl) FetchRunners(ctx context.Context, owner, repo, org string) ([]*entity.Runner, error) {
 	path := r.getRunnersPath(owner, repo, org)
@@ -73,20 +83,98 @@ func (r *RunnerRepositoryImpl) getRunnersPath(owner, repo, org string) string {
 	return fmt.Sprintf("repos/%s/%s/actions/runners", owner, repo)
 }
 
-// requestGetRunners fetches runners from GitHub API
-func (r *RunnerRepositoryImpl) requestGetRunners(path string) (*runnersResponse, error) {
-	response, err := r.restClient.Request(http.MethodGet, path, nil)
+// DeleteRunner removes a runner from a repository or organization
+func (r *RunnerRepositoryImpl) DeleteRunner(ctx context.Context, owner, repo, org string, runnerID int64) error {
+	path := fmt.Sprintf("%s/%d", r.getRunnersPath(owner, repo, org), runnerID)
+	if err := r.request(ctx, http.MethodDelete, path, nil); err != nil {
+		return fmt.Errorf("failed to delete runner: %w", err)
+	}
+	return nil
+}
+
+// AddLabels adds custom labels to a runner
+func (r *RunnerRepositoryImpl) AddLabels(ctx context.Context, owner, repo, org string, runnerID int64, labels []string) error {
+	path := r.getRunnerLabelsPath(owner, repo, org, runnerID)
+	if err := r.request(ctx, http.MethodPost, path, labelsRequest{Labels: labels}); err != nil {
+		return fmt.Errorf("failed to add labels: %w", err)
+	}
+	return nil
+}
+
+// RemoveLabel removes a custom label from a runner
+func (r *RunnerRepositoryImpl) RemoveLabel(ctx context.Context, owner, repo, org string, runnerID int64, label string) error {
+	path := fmt.Sprintf("%s/%s", r.getRunnerLabelsPath(owner, repo, org, runnerID), url.PathEscape(label))
+	if err := r.request(ctx, http.MethodDelete, path, nil); err != nil {
+		return fmt.Errorf("failed to remove label: %w", err)
+	}
+	return nil
+}
+
+// SetLabels replaces all custom labels of a runner
+func (r *RunnerRepositoryImpl) SetLabels(ctx context.Context, owner, repo, org string, runnerID int64, labels []string) error {
+	path := r.getRunnerLabelsPath(owner, repo, org, runnerID)
+	if err := r.request(ctx, http.MethodPut, path, labelsRequest{Labels: labels}); err != nil {
+		return fmt.Errorf("failed to set labels: %w", err)
+	}
+	return nil
+}
+
+// getRunnerLabelsPath constructs the API path for the labels of a runner
+func (r *RunnerRepositoryImpl) getRunnerLabelsPath(owner, repo, org string, runnerID int64) string {
+	return fmt.Sprintf("%s/%d/labels", r.getRunnersPath(owner, repo, org), runnerID)
+}
+
+// request sends a request with an optional JSON body and discards the response
+func (r *RunnerRepositoryImpl) request(ctx context.Context, method, path string, body any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	response, err := r.restClient.RequestWithContext(ctx, method, path, reader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to request runners: %w", err)
+		return err
+	}
+	return response.Body.Close()
+}
+
+// requestGetRunners fetches runners from GitHub API with pagination support
+func (r *RunnerRepositoryImpl) requestGetRunners(path string) (*runnersResponse, error) {
+	allRunners := &runnersResponse{
+		Runners: []runnerResponse{},
 	}
-	defer func() {
+
+	page := 1
+	perPage := 100
+
+	for {
+		currentPath := fmt.Sprintf("%s?per_page=%d&page=%d", path, perPage, page)
+		response, err := r.restClient.Request(http.MethodGet, currentPath, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request runners: %w", err)
+		}
+
+		var runners runnersResponse
+		if err := json.NewDecoder(response.Body).Decode(&runners); err != nil {
+			_ = response.Body.Close()
+			return nil, fmt.Errorf("failed to decode runners response: %w", err)
+		}
 		_ = response.Body.Close()
-	}()
 
-	var runners runnersResponse
-	if err := json.NewDecoder(response.Body).Decode(&runners); err != nil {
-		return nil, fmt.Errorf("failed to decode runners response: %w", err)
+		allRunners.TotalCount = runners.TotalCount
+		allRunners.Runners = append(allRunners.Runners, runners.Runners...)
+
+		// If we got fewer items than per_page, we've reached the last page
+		if len(runners.Runners) < perPage {
+			break
+		}
+
+		page++
 	}
 
-	return &runners, nil
+	return allRunners, nil
 }
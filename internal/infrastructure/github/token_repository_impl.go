@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	domainrepo "github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// TokenRepositoryImpl implements the TokenRepository interface using GitHub API
+type TokenRepositoryImpl struct {
+	restClient *api.RESTClient
+}
+
+// NewTokenRepository creates a new instance of TokenRepositoryImpl
+func NewTokenRepository() (domainrepo.TokenRepository, error) {
+	restClient, err := api.DefaultRESTClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create REST client: %w\nPlease run 'gh auth login' to authenticate with GitHub", err)
+	}
+
+	return &TokenRepositoryImpl{
+		restClient: restClient,
+	}, nil
+}
+
+// CreateRegistrationToken creates a token for registering a runner in the scope
+func (t *TokenRepositoryImpl) CreateRegistrationToken(ctx context.Context, scope value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	return t.createToken(ctx, scope, value_object.TokenRegistration, "registration-token")
+}
+
+// CreateRemoveToken creates a token for removing a runner from the scope
+func (t *TokenRepositoryImpl) CreateRemoveToken(ctx context.Context, scope value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	return t.createToken(ctx, scope, value_object.TokenRemove, "remove-token")
+}
+
+// createToken requests a token from the given endpoint of the scope
+func (t *TokenRepositoryImpl) createToken(ctx context.Context, scope value_object.RunnerScope, kind value_object.TokenKind, endpoint string) (*value_object.RunnerToken, error) {
+	path := fmt.Sprintf("%s/actions/runners/%s", getScopePath(scope), endpoint)
+	response, err := t.restClient.RequestWithContext(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s token: %w", kind, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	var token runnerTokenResponse
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	return &value_object.RunnerToken{
+		Kind:      kind,
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt,
+	}, nil
+}
+
+// getScopePath constructs the API path prefix of a runner scope
+func getScopePath(scope value_object.RunnerScope) string {
+	switch {
+	case scope.Enterprise != "":
+		return fmt.Sprintf("enterprises/%s", scope.Enterprise)
+	case scope.Org != "":
+		return fmt.Sprintf("orgs/%s", scope.Org)
+	default:
+		return fmt.Sprintf("repos/%s/%s", scope.Owner, scope.Repo)
+	}
+}
@@ -23,6 +23,10 @@ type label struct {
 	Type string `json:"type"`
 }
 
+type labelsRequest struct {
+	Labels []string `json:"labels"`
+}
+
 type workflowRunsResponse struct {
 	TotalCount   int           `json:"total_count"`
 	WorkflowRuns []workflowRun `json:"workflow_runs"`
@@ -49,14 +53,39 @@ type jobsResponse struct {
 }
 
 type jobResponse struct {
-	ID          int64      `json:"id"`
-	RunID       int64      `json:"run_id"`
+	ID          int64          `json:"id"`
+	RunID       int64          `json:"run_id"`
+	Name        string         `json:"name"`
+	Status      string         `json:"status"`
+	Conclusion  *string        `json:"conclusion"`
+	CreatedAt   *time.Time     `json:"created_at"`
+	StartedAt   *time.Time     `json:"started_at"`
+	CompletedAt *time.Time     `json:"completed_at"`
+	RunnerID    *int64         `json:"runner_id"`
+	RunnerName  *string        `json:"runner_name"`
+	Labels      []string       `json:"labels"`
+	HtmlUrl     string         `json:"html_url"`
+	Steps       []stepResponse `json:"steps"`
+}
+
+type stepResponse struct {
+	Number      int        `json:"number"`
 	Name        string     `json:"name"`
 	Status      string     `json:"status"`
 	Conclusion  *string    `json:"conclusion"`
 	StartedAt   *time.Time `json:"started_at"`
 	CompletedAt *time.Time `json:"completed_at"`
-	RunnerID    *int64     `json:"runner_id"`
-	RunnerName  *string    `json:"runner_name"`
-	HtmlUrl     string     `json:"html_url"`
+}
+
+type runnerTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type runnerDownloadResponse struct {
+	OS             string `json:"os"`
+	Architecture   string `json:"architecture"`
+	DownloadURL    string `json:"download_url"`
+	Filename       string `json:"filename"`
+	SHA256Checksum string `json:"sha256_checksum"`
 }
@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// commandTimeout bounds each command run so a hanging script cannot block further notifications
+const commandTimeout = 30 * time.Second
+
+// CommandNotifier runs a local command for each alert event
+// The event is passed as JSON on stdin and as ALERT_* environment variables
+type CommandNotifier struct {
+	args    []string
+	timeout time.Duration
+}
+
+// NewCommandNotifier creates a new notifier running the given command and arguments
+func NewCommandNotifier(args []string) repository.Notifier {
+	return &CommandNotifier{
+		args:    args,
+		timeout: commandTimeout,
+	}
+}
+
+// Notify runs the command and waits for it to finish
+// The command is killed when it exceeds the timeout or ctx is done
+func (c *CommandNotifier) Notify(ctx context.Context, event *value_object.AlertEvent) error {
+	if len(c.args) == 0 {
+		return fmt.Errorf("no command configured")
+	}
+
+	b, err := json.Marshal(newAlertPayload(event))
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.args[0], c.args[1:]...)
+	cmd.Stdin = bytes.NewReader(b)
+	cmd.Env = append(os.Environ(),
+		"ALERT_RULE="+event.Rule.Name,
+		"ALERT_STATE="+string(event.State),
+		"ALERT_MESSAGE="+event.Message,
+		"ALERT_SUBJECTS="+strings.Join(event.Subjects, ","),
+	)
+	// Children of the command may keep its output open after it was killed
+	cmd.WaitDelay = time.Second
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("command failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
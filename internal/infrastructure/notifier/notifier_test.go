@@ -0,0 +1,187 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func newTestEvent(state value_object.AlertState) *value_object.AlertEvent {
+	return &value_object.AlertEvent{
+		Rule: value_object.AlertRule{
+			Name:      "no-idle",
+			Condition: value_object.ConditionRunnerCount,
+		},
+		State:    state,
+		Message:  "no idle runners",
+		Subjects: []string{"runner-1", "runner-2"},
+		At:       time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+// captureServer records the last request body and headers and answers with the given status
+func captureServer(t *testing.T, status int) (*httptest.Server, *[]byte, *http.Header) {
+	t.Helper()
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header.Clone()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &body, &header
+}
+
+func TestSlackNotifier_Notify(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    value_object.AlertState
+		expected string
+	}{
+		{
+			name:     "firing",
+			state:    value_object.AlertFiring,
+			expected: ":rotating_light: [FIRING] no idle runners (runner-1, runner-2)",
+		},
+		{
+			name:     "resolved",
+			state:    value_object.AlertResolved,
+			expected: ":white_check_mark: [RESOLVED] no idle runners (runner-1, runner-2)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, body, _ := captureServer(t, http.StatusOK)
+
+			if err := NewSlackNotifier(server.URL).Notify(context.Background(), newTestEvent(tt.state)); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var message slackMessage
+			if err := json.Unmarshal(*body, &message); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if message.Text != tt.expected {
+				t.Errorf("Expected text %q, got %q", tt.expected, message.Text)
+			}
+		})
+	}
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Run("posts the event as JSON with the configured headers", func(t *testing.T) {
+		server, body, header := captureServer(t, http.StatusNoContent)
+		notifier := NewWebhookNotifier(server.URL, map[string]string{"Authorization": "Bearer secret"})
+
+		if err := notifier.Notify(context.Background(), newTestEvent(value_object.AlertFiring)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if got := header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Expected Content-Type application/json, got %q", got)
+		}
+		if got := header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Expected the configured Authorization header, got %q", got)
+		}
+
+		var payload alertPayload
+		if err := json.Unmarshal(*body, &payload); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := newAlertPayload(newTestEvent(value_object.AlertFiring))
+		if payload.Rule != expected.Rule || payload.Condition != expected.Condition || payload.State != expected.State ||
+			payload.Message != expected.Message || !payload.At.Equal(expected.At) ||
+			strings.Join(payload.Subjects, ",") != strings.Join(expected.Subjects, ",") {
+			t.Errorf("Expected payload %+v, got %+v", expected, payload)
+		}
+	})
+
+	t.Run("fails on a non-2xx response", func(t *testing.T) {
+		server, _, _ := captureServer(t, http.StatusInternalServerError)
+
+		err := NewWebhookNotifier(server.URL, nil).Notify(context.Background(), newTestEvent(value_object.AlertFiring))
+		if err == nil || !strings.Contains(err.Error(), "500") {
+			t.Errorf("Expected an error mentioning the status code, got %v", err)
+		}
+	})
+}
+
+func TestCommandNotifier_Notify(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	t.Run("passes the event on stdin and in the environment", func(t *testing.T) {
+		out := filepath.Join(t.TempDir(), "out")
+		notifier := NewCommandNotifier([]string{"sh", "-c",
+			`{ echo "$ALERT_RULE|$ALERT_STATE|$ALERT_MESSAGE|$ALERT_SUBJECTS"; cat; } > "$0"`, out})
+
+		if err := notifier.Notify(context.Background(), newTestEvent(value_object.AlertFiring)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		b, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		env, stdin, _ := strings.Cut(string(b), "\n")
+		if env != "no-idle|firing|no idle runners|runner-1,runner-2" {
+			t.Errorf("Unexpected environment: %q", env)
+		}
+		var payload alertPayload
+		if err := json.Unmarshal([]byte(stdin), &payload); err != nil {
+			t.Fatalf("Expected the payload on stdin: %v", err)
+		}
+		if payload.Rule != "no-idle" || payload.State != "firing" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+	})
+
+	t.Run("fails with the output on a non-zero exit code", func(t *testing.T) {
+		notifier := NewCommandNotifier([]string{"sh", "-c", "echo boom; exit 3"})
+
+		err := notifier.Notify(context.Background(), newTestEvent(value_object.AlertFiring))
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != 3 {
+			t.Errorf("Expected exit code 3, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "boom") {
+			t.Errorf("Expected the command output in the error, got %v", err)
+		}
+	})
+
+	t.Run("kills the command after the timeout", func(t *testing.T) {
+		notifier := &CommandNotifier{args: []string{"sh", "-c", "sleep 10"}, timeout: 100 * time.Millisecond}
+
+		start := time.Now()
+		err := notifier.Notify(context.Background(), newTestEvent(value_object.AlertFiring))
+		if err == nil {
+			t.Error("Expected an error")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Expected the command to be killed, took %s", elapsed)
+		}
+	})
+
+	t.Run("fails without a command", func(t *testing.T) {
+		if err := NewCommandNotifier(nil).Notify(context.Background(), newTestEvent(value_object.AlertFiring)); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
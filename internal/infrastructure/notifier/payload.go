@@ -0,0 +1,40 @@
+package notifier
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// alertPayload is the JSON representation of an alert event sent to webhooks and commands
+type alertPayload struct {
+	Rule      string    `json:"rule"`
+	Condition string    `json:"condition"`
+	State     string    `json:"state"`
+	Message   string    `json:"message"`
+	Subjects  []string  `json:"subjects"`
+	At        time.Time `json:"at"`
+}
+
+// newAlertPayload converts an alert event into its JSON representation
+func newAlertPayload(event *value_object.AlertEvent) alertPayload {
+	return alertPayload{
+		Rule:      event.Rule.Name,
+		Condition: string(event.Rule.Condition),
+		State:     string(event.State),
+		Message:   event.Message,
+		Subjects:  event.Subjects,
+		At:        event.At,
+	}
+}
+
+// formatAlertText formats an alert event as a single human-readable message
+func formatAlertText(event *value_object.AlertEvent) string {
+	text := fmt.Sprintf("[%s] %s", strings.ToUpper(string(event.State)), event.Message)
+	if len(event.Subjects) > 0 {
+		text += fmt.Sprintf(" (%s)", strings.Join(event.Subjects, ", "))
+	}
+	return text
+}
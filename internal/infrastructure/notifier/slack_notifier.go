@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// SlackNotifier posts alert events to a Slack-compatible incoming webhook
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// slackMessage is the minimal incoming webhook payload understood by Slack and compatible services
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// NewSlackNotifier creates a new notifier posting to the given incoming webhook URL
+func NewSlackNotifier(url string) repository.Notifier {
+	return &SlackNotifier{
+		url:    url,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Notify posts the alert event as a text message
+func (s *SlackNotifier) Notify(ctx context.Context, event *value_object.AlertEvent) error {
+	icon := ":rotating_light:"
+	if event.State == value_object.AlertResolved {
+		icon = ":white_check_mark:"
+	}
+	return postJSON(ctx, s.client, s.url, nil, slackMessage{
+		Text: icon + " " + formatAlertText(event),
+	})
+}
@@ -0,0 +1,67 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// requestTimeout bounds each webhook request so a slow endpoint cannot stall monitoring
+const requestTimeout = 10 * time.Second
+
+// WebhookNotifier posts alert events as JSON to a generic webhook
+type WebhookNotifier struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookNotifier creates a new notifier posting to the given URL with optional extra headers
+func NewWebhookNotifier(url string, headers map[string]string) repository.Notifier {
+	return &WebhookNotifier{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Notify posts the alert event as JSON
+func (w *WebhookNotifier) Notify(ctx context.Context, event *value_object.AlertEvent) error {
+	return postJSON(ctx, w.client, w.url, w.headers, newAlertPayload(event))
+}
+
+// postJSON sends body as JSON and treats any non-2xx response as an error
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		request.Header.Set(k, v)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package presentation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/infrastructure/debug"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fleetSizes are the fleet sizes the rendering benchmarks run at
+var fleetSizes = []struct{ runners, jobs int }{{100, 40}, {1000, 400}, {5000, 2000}}
+
+// newFleetModel creates a model showing a synthetic fleet in a typical terminal
+func newFleetModel(runners, jobs int) *Model {
+	data := debug.GenerateFleet(debug.FleetOptions{Runners: runners, Jobs: jobs, OfflineRatio: 0.05, QueuedRatio: 0.2, Seed: 1})
+	m := NewModel(nil, "owner", "repo", "", 5, Options{})
+	m.Update(tea.WindowSizeMsg{Width: 160, Height: 50})
+	m.loading = false
+	m.runners = data.Runners
+	m.jobs = data.Jobs
+	m.jobIndex = value_object.NewJobIndex(data.Runners, data.Jobs)
+	m.currentTime = data.CurrentTime
+	m.updateTableRows()
+	return m
+}
+
+func BenchmarkUpdateTableRows(b *testing.B) {
+	for _, size := range fleetSizes {
+		b.Run(fmt.Sprintf("runners=%d/jobs=%d", size.runners, size.jobs), func(b *testing.B) {
+			m := newFleetModel(size.runners, size.jobs)
+			for b.Loop() {
+				m.updateTableRows()
+			}
+		})
+	}
+}
+
+func BenchmarkView(b *testing.B) {
+	for _, size := range fleetSizes {
+		b.Run(fmt.Sprintf("runners=%d/jobs=%d", size.runners, size.jobs), func(b *testing.B) {
+			m := newFleetModel(size.runners, size.jobs)
+			for b.Loop() {
+				_ = m.View()
+			}
+		})
+	}
+}
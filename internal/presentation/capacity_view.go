@@ -0,0 +1,48 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// capacityView renders the capacity summary in place of the runner table
+func (m *Model) capacityView() string {
+	return m.viewportView(fmt.Sprintf("Capacity by label (%d labels, highest pressure first) | 'L'/'esc' to close, ↑/↓ to scroll",
+		len(m.capacities)))
+}
+
+// capacityContent renders one aligned line per label below a header line
+func (m *Model) capacityContent() string {
+	if len(m.capacities) == 0 {
+		return "No labels"
+	}
+
+	width := len(columnTitleLabel)
+	for _, capacity := range m.capacities {
+		width = max(width, len([]rune(capacity.Label)))
+	}
+
+	lines := make([]string, 0, len(m.capacities)+1)
+	lines = append(lines, fmt.Sprintf("%-*s  %5s  %5s  %6s  %7s  %6s  %12s  %8s",
+		width, columnTitleLabel, "Total", "Idle", "Active", "Offline", "Queued", "Longest wait", "Pressure"))
+	for _, capacity := range m.capacities {
+		line := fmt.Sprintf("%-*s  %5d  %5d  %6d  %7d  %6d  %12s  %8d",
+			width, capacity.Label, capacity.Total, capacity.Idle, capacity.Active, capacity.Offline,
+			capacity.QueuedJobs, formatLongestWait(capacity), capacity.Pressure())
+		if capacity.Pressure() > 0 {
+			line = stuckJobStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatLongestWait formats the longest queue wait of a label, or "-" without queued jobs
+func formatLongestWait(capacity *value_object.LabelCapacity) string {
+	if capacity.QueuedJobs == 0 {
+		return "-"
+	}
+	return formatDuration(capacity.LongestQueueWait)
+}
@@ -0,0 +1,59 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestCapacityView(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-3 * time.Minute)
+	data := &value_object.MonitorData{
+		CurrentTime: now,
+		Runners: []*entity.Runner{
+			{ID: 1, Name: "gpu-01", Status: entity.StatusActive, Labels: []string{"gpu"}},
+		},
+		Jobs: []*entity.Job{
+			{ID: 100, Name: "train", Status: "queued", Labels: []string{"gpu"}, CreatedAt: &createdAt},
+		},
+	}
+	pressL := key("L")
+
+	t.Run("toggles the summary", func(t *testing.T) {
+		m := NewModel(nil, "owner", "repo", "", 5, Options{})
+		m.loading = false
+		m.Update(value_object.DataMsg{Data: data})
+
+		m.Update(pressL)
+		if m.view != viewCapacity || m.table.Focused() {
+			t.Fatal("expected the capacity summary to be shown")
+		}
+		view := m.View()
+		if !strings.Contains(view, "Capacity by label (1 labels") {
+			t.Errorf("expected the capacity title, got %q", view)
+		}
+		if !strings.Contains(view, "gpu") || !strings.Contains(view, "03:00") {
+			t.Errorf("expected the gpu label with its longest wait, got %q", view)
+		}
+
+		m.Update(pressL)
+		if m.view != viewTable || !m.table.Focused() {
+			t.Error("expected the runner table to be shown again")
+		}
+	})
+
+	t.Run("summarizes new snapshots while shown", func(t *testing.T) {
+		m := NewModel(nil, "owner", "repo", "", 5, Options{})
+		m.Update(pressL)
+
+		m.Update(value_object.DataMsg{Data: data})
+
+		if len(m.capacities) != 1 || m.capacities[0].QueuedJobs != 1 {
+			t.Errorf("expected one gpu label with a queued job, got %v", m.capacities)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package presentation
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cli/go-gh/v2/pkg/term"
+)
+
+// clipboardMsg reports the result of writing a value to the clipboard
+type clipboardMsg struct {
+	target copyTarget
+	err    error
+}
+
+// copyTarget is a value of the selected job that can be copied to the clipboard
+type copyTarget struct {
+	key         string
+	description string
+	value       string
+}
+
+// startCopyChoice asks which value of the selected runner's job to copy to the clipboard
+func (m *Model) startCopyChoice() tea.Cmd {
+	runner := m.selectedRunner()
+	job := m.selectedJob()
+	if runner == nil || job == nil {
+		return m.setStatusMessage("No job is assigned to the selected runner")
+	}
+
+	m.copyTargets = m.getCopyTargets(runner, job)
+	m.mode = modeCopyChoice
+	m.table.Blur()
+	return nil
+}
+
+// getCopyTargets lists the values of the job that can be copied, with the key choosing each
+func (m *Model) getCopyTargets(runner *entity.Runner, job *entity.Job) []copyTarget {
+	var targets []copyTarget
+	if job.HtmlUrl != "" {
+		targets = append(targets, copyTarget{key: "u", description: "job URL", value: job.HtmlUrl})
+	}
+	targets = append(targets,
+		copyTarget{key: "i", description: "run ID", value: fmt.Sprint(job.RunID)},
+		copyTarget{key: "n", description: "runner name", value: runner.Name},
+		copyTarget{key: "g", description: "gh command", value: m.getRunViewCommand(job)},
+	)
+	return targets
+}
+
+// getRunViewCommand returns the gh command showing the workflow run of the job
+// The job's repository is used when known, otherwise the monitored repository
+func (m *Model) getRunViewCommand(job *entity.Job) string {
+	command := fmt.Sprintf("gh run view %d", job.RunID)
+	repository := job.Repository
+	if repository == "" && m.owner != "" && m.repo != "" {
+		repository = m.owner + "/" + m.repo
+	}
+	if repository != "" {
+		command += " --repo " + repository
+	}
+	return command
+}
+
+// updateCopyChoice copies the value chosen by the key, or closes the dialog on any other key
+func (m *Model) updateCopyChoice(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	targets := m.copyTargets
+	m.copyTargets = nil
+	m.cancelInput()
+
+	for _, target := range targets {
+		if msg.String() == target.key {
+			return m, m.copyToClipboard(target)
+		}
+	}
+	return m, nil
+}
+
+// copyToClipboard returns a command writing the value to the clipboard of the terminal with an OSC 52 escape sequence
+// This works over SSH as long as the local terminal supports OSC 52
+func (m *Model) copyToClipboard(target copyTarget) tea.Cmd {
+	if m.clipboard == nil {
+		return m.setStatusMessage(fmt.Sprintf("Error: cannot copy the %s, stderr is not a terminal", target.description))
+	}
+
+	sequence := osc52.New(target.value)
+	switch {
+	case os.Getenv("TMUX") != "":
+		sequence = sequence.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		sequence = sequence.Screen()
+	}
+
+	out := m.clipboard
+	return func() tea.Msg {
+		_, err := sequence.WriteTo(out)
+		return clipboardMsg{target: target, err: err}
+	}
+}
+
+// applyClipboardResult reports whether the value was handed to the terminal
+func (m *Model) applyClipboardResult(msg clipboardMsg) tea.Cmd {
+	if msg.err != nil {
+		return m.setStatusMessage(fmt.Sprintf("Error: failed to copy the %s: %s", msg.target.description, msg.err))
+	}
+	return m.setStatusMessage(fmt.Sprintf("Copied the %s: %s", msg.target.description, msg.target.value))
+}
+
+// copyChoiceView renders the choice of values to copy
+func (m *Model) copyChoiceView() string {
+	lines := []string{"Copy to the clipboard:", ""}
+	for _, target := range m.copyTargets {
+		lines = append(lines, fmt.Sprintf("'%s' %-11s  %s", target.key, target.description, target.value))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// newClipboardOutput returns where the OSC 52 sequences are written, or nil when they cannot reach a terminal
+// The terminal is reached through stderr, so that the sequences do not interleave with the rendered view
+func newClipboardOutput() io.Writer {
+	if !term.IsTerminal(os.Stderr) {
+		return nil
+	}
+	return os.Stderr
+}
@@ -0,0 +1,132 @@
+package presentation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestCopyToClipboard(t *testing.T) {
+	newModel := func() (*Model, *bytes.Buffer) {
+		m := newBuildModel(Options{})
+		clipboard := &bytes.Buffer{}
+		m.clipboard = clipboard
+		return m, clipboard
+	}
+	// press sends the key and feeds the result of a copy back like the program loop does
+	press := func(m *Model, k string) {
+		_, cmd := m.Update(key(k))
+		if k == "y" || cmd == nil {
+			return
+		}
+		if msg, ok := cmd().(clipboardMsg); ok {
+			m.Update(msg)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		expected string
+		message  string
+	}{
+		{name: "job URL", key: "u", expected: "https://github.com/owner/repo/actions/runs/100/job/10",
+			message: "Copied the job URL: https://github.com/owner/repo/actions/runs/100/job/10"},
+		{name: "run ID", key: "i", expected: "100", message: "Copied the run ID: 100"},
+		{name: "runner name", key: "n", expected: "runner-1", message: "Copied the runner name: runner-1"},
+		{name: "gh command", key: "g", expected: "gh run view 100 --repo owner/repo",
+			message: "Copied the gh command: gh run view 100 --repo owner/repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TMUX", "")
+			t.Setenv("TERM", "xterm-256color")
+			m, clipboard := newModel()
+
+			press(m, "y")
+			if m.mode != modeCopyChoice {
+				t.Fatal("expected the copy choice dialog")
+			}
+			press(m, tt.key)
+
+			expected := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(tt.expected)) + "\x07"
+			if clipboard.String() != expected {
+				t.Errorf("expected OSC 52 sequence %q, got %q", expected, clipboard.String())
+			}
+			if m.statusMessage != tt.message {
+				t.Errorf("expected status message %q, got %q", tt.message, m.statusMessage)
+			}
+			if m.mode != modeNormal || !m.table.Focused() {
+				t.Error("expected the runner table to be shown again")
+			}
+		})
+	}
+
+	t.Run("wraps the sequence for tmux", func(t *testing.T) {
+		t.Setenv("TMUX", "/tmp/tmux-1000/default,1,0")
+		m, clipboard := newModel()
+
+		press(m, "y")
+		press(m, "i")
+
+		if !strings.HasPrefix(clipboard.String(), "\x1bPtmux;") {
+			t.Errorf("expected a tmux passthrough sequence, got %q", clipboard.String())
+		}
+	})
+
+	t.Run("copies in a command", func(t *testing.T) {
+		m, clipboard := newModel()
+
+		m.Update(key("y"))
+		_, cmd := m.Update(key("i"))
+
+		if clipboard.Len() != 0 || m.statusMessage != "" {
+			t.Error("expected nothing to be written before the command runs")
+		}
+		if cmd == nil {
+			t.Fatal("expected a copy command")
+		}
+		if msg, ok := cmd().(clipboardMsg); !ok || msg.err != nil || clipboard.Len() == 0 {
+			t.Errorf("expected the sequence to be written by the command, got %+v", msg)
+		}
+	})
+
+	t.Run("does not claim success without a terminal", func(t *testing.T) {
+		m, _ := newModel()
+		m.clipboard = nil
+
+		press(m, "y")
+		m.Update(key("i"))
+
+		if m.statusMessage != "Error: cannot copy the run ID, stderr is not a terminal" {
+			t.Errorf("expected an error without a terminal, got %q", m.statusMessage)
+		}
+	})
+
+	t.Run("any other key cancels", func(t *testing.T) {
+		m, clipboard := newModel()
+
+		press(m, "y")
+		press(m, "x")
+
+		if clipboard.Len() != 0 || m.mode != modeNormal {
+			t.Error("expected nothing to be copied")
+		}
+	})
+
+	t.Run("needs a job", func(t *testing.T) {
+		m, clipboard := newModel()
+		m.table.SetCursor(1)
+
+		press(m, "y")
+
+		if m.mode != modeNormal || clipboard.Len() != 0 {
+			t.Error("expected no copy choice for an idle runner")
+		}
+		if m.statusMessage != "No job is assigned to the selected runner" {
+			t.Errorf("expected a status message for an idle runner, got %q", m.statusMessage)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package presentation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// maxEventLogSize is the number of events kept in memory
+	maxEventLogSize = 1000
+	// eventLogPanelLines is the number of recent events shown below the table
+	eventLogPanelLines = 5
+	// eventLogPaneLines is the height of the scrollable event log pane
+	eventLogPaneLines = 10
+)
+
+// eventLogExportedMsg reports the result of exporting the event log
+type eventLogExportedMsg struct {
+	path  string
+	count int
+	err   error
+}
+
+// appendEvents adds events to the log, dropping the oldest ones beyond maxEventLogSize
+func (m *Model) appendEvents(events []*value_object.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	m.eventLog = append(m.eventLog, events...)
+	if len(m.eventLog) > maxEventLogSize {
+		m.eventLog = m.eventLog[len(m.eventLog)-maxEventLogSize:]
+	}
+
+	// Keep following new events unless the user scrolled up
+	atBottom := m.eventViewport.AtBottom()
+	m.eventViewport.SetContent(m.eventLogContent())
+	if atBottom {
+		m.eventViewport.GotoBottom()
+	}
+}
+
+// toggleEventLog opens or closes the scrollable event log pane
+func (m *Model) toggleEventLog() {
+	m.showEventLog = !m.showEventLog
+	if m.showEventLog {
+		m.table.Blur()
+		m.eventViewport.Width = m.width
+		m.eventViewport.Height = eventLogPaneLines
+		m.eventViewport.SetContent(m.eventLogContent())
+		m.eventViewport.GotoBottom()
+	} else {
+		m.table.Focus()
+	}
+	m.updateTableHeight()
+}
+
+// getEventLogPanelHeight returns the number of lines used by the event log panel or pane
+func (m *Model) getEventLogPanelHeight() int {
+	if m.showEventLog {
+		return 2 + eventLogPaneLines // Blank line, title and pane
+	}
+	if len(m.eventLog) == 0 {
+		return 0
+	}
+	return 2 + min(len(m.eventLog), eventLogPanelLines) // Blank line, title and events
+}
+
+// eventLogPanelView renders the event log pane when open, or the most recent events otherwise
+func (m *Model) eventLogPanelView() string {
+	if m.showEventLog {
+		return fmt.Sprintf("\n\nEvent Log (%d events) | 'e'/'esc' to close, ↑/↓ to scroll, 'x' to export:\n%s",
+			len(m.eventLog), m.eventViewport.View())
+	}
+
+	if len(m.eventLog) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\nRecent Events:")
+	for _, event := range m.eventLog[max(len(m.eventLog)-eventLogPanelLines, 0):] {
+		b.WriteString("\n" + formatEvent(event))
+	}
+	return b.String()
+}
+
+// eventLogContent renders every event in the log, one per line
+func (m *Model) eventLogContent() string {
+	if len(m.eventLog) == 0 {
+		return "No events yet"
+	}
+	lines := make([]string, 0, len(m.eventLog))
+	for _, event := range m.eventLog {
+		lines = append(lines, formatEvent(event))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// exportEventLog writes the event log to a file in the current directory
+func (m *Model) exportEventLog() tea.Cmd {
+	events := append([]*value_object.Event(nil), m.eventLog...)
+	path := fmt.Sprintf("gh-runner-monitor-events-%s.log", time.Now().Format("20060102-150405"))
+
+	return func() tea.Msg {
+		var b strings.Builder
+		for _, event := range events {
+			b.WriteString(formatExportedEvent(event) + "\n")
+		}
+		if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+			return eventLogExportedMsg{err: fmt.Errorf("failed to export event log: %w", err)}
+		}
+		return eventLogExportedMsg{path: path, count: len(events)}
+	}
+}
+
+// formatEvent formats an event as a single log line
+func formatEvent(event *value_object.Event) string {
+	return fmt.Sprintf("%s %s %s", event.At.Format("15:04:05"), getEventIcon(event.Type), event.Message)
+}
+
+// formatExportedEvent formats an event with its full timestamp and type for export
+func formatExportedEvent(event *value_object.Event) string {
+	return fmt.Sprintf("%s\t%s\t%s", event.At.Format(time.RFC3339), event.Type, event.Message)
+}
+
+// newEventViewport creates the viewport backing the event log pane
+func newEventViewport() viewport.Model {
+	return viewport.New(defaultTerminalWidth, eventLogPaneLines)
+}
+
+// getEventIcon returns the icon for an event type
+func getEventIcon(eventType value_object.EventType) string {
+	switch eventType {
+	case value_object.EventRunnerAdded:
+		return "➕"
+	case value_object.EventRunnerRemoved:
+		return "➖"
+	case value_object.EventRunnerOffline:
+		return "⚫"
+	case value_object.EventRunnerOnline:
+		return "🟢"
+	case value_object.EventRunnerStatusChanged:
+		return "🔄"
+	case value_object.EventRunnerLabelsChanged:
+		return "🏷"
+	case value_object.EventJobQueued:
+		return "⏸"
+	case value_object.EventJobStarted:
+		return "▶"
+	case value_object.EventJobFinished:
+		return "✔"
+	case value_object.EventAction:
+		return "⚡"
+	default:
+		return "•"
+	}
+}
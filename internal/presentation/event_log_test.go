@@ -0,0 +1,77 @@
+package presentation
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestAppendEvents(t *testing.T) {
+	model := &Model{}
+
+	for i := 0; i < maxEventLogSize+10; i++ {
+		model.appendEvents([]*value_object.Event{{Type: value_object.EventJobStarted, Message: "started"}})
+	}
+
+	if len(model.eventLog) != maxEventLogSize {
+		t.Errorf("expected event log to be capped at %d, got %d", maxEventLogSize, len(model.eventLog))
+	}
+}
+
+func TestEventLogPanelView(t *testing.T) {
+	at := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+
+	t.Run("empty log renders nothing", func(t *testing.T) {
+		model := &Model{}
+		if view := model.eventLogPanelView(); view != "" {
+			t.Errorf("expected empty panel, got %q", view)
+		}
+	})
+
+	t.Run("shows only the most recent events", func(t *testing.T) {
+		model := &Model{}
+		for i := 0; i < eventLogPanelLines+2; i++ {
+			model.appendEvents([]*value_object.Event{{Type: value_object.EventRunnerOffline, At: at, Message: "offline"}})
+		}
+
+		view := model.eventLogPanelView()
+		if count := strings.Count(view, "offline"); count != eventLogPanelLines {
+			t.Errorf("expected %d events in panel, got %d", eventLogPanelLines, count)
+		}
+		if height := model.getEventLogPanelHeight(); height != eventLogPanelLines+2 {
+			t.Errorf("expected panel height %d, got %d", eventLogPanelLines+2, height)
+		}
+	})
+}
+
+func TestExportEventLog(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	model := &Model{}
+	model.appendEvents([]*value_object.Event{
+		{Type: value_object.EventRunnerOffline, At: time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC), Message: "Runner r1 went offline"},
+	})
+
+	msg, ok := model.exportEventLog()().(eventLogExportedMsg)
+	if !ok {
+		t.Fatal("expected eventLogExportedMsg")
+	}
+	if msg.err != nil {
+		t.Fatalf("unexpected error: %v", msg.err)
+	}
+	if msg.count != 1 {
+		t.Errorf("expected 1 exported event, got %d", msg.count)
+	}
+
+	content, err := os.ReadFile(msg.path)
+	if err != nil {
+		t.Fatalf("failed to read exported file: %v", err)
+	}
+	expected := "2025-11-03T10:00:00Z\trunner_offline\tRunner r1 went offline\n"
+	if string(content) != expected {
+		t.Errorf("expected %q, got %q", expected, string(content))
+	}
+}
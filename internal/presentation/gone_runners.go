@@ -0,0 +1,67 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// goneRunnerPanelLines is the number of recently gone runners shown below the table
+	goneRunnerPanelLines = 5
+	// churnSummaryLabels is the number of labels shown in the churn summary
+	churnSummaryLabels = 5
+)
+
+// getGoneRunnerPanelHeight returns the number of lines used by the recently gone runners panel
+func (m *Model) getGoneRunnerPanelHeight() int {
+	if len(m.goneRunners) == 0 {
+		return 0
+	}
+	return 2 + min(len(m.goneRunners), goneRunnerPanelLines) // Blank line, title and runners
+}
+
+// goneRunnerPanelView renders the most recently gone runners with their lifetime and last job
+func (m *Model) goneRunnerPanelView() string {
+	if len(m.goneRunners) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n\nRecently Gone (%d in the last %s):", len(m.goneRunners), formatRetention(m.goneRetention))
+	for _, gone := range m.goneRunners[:min(len(m.goneRunners), goneRunnerPanelLines)] {
+		lastJob := "no job"
+		if gone.LastJob != nil {
+			lastJob = fmt.Sprintf("last job: %s (%s)", gone.LastJob.Name, gone.LastJob.WorkflowName)
+		}
+		// A runner registered before the monitor started lived longer than it was seen
+		lifetime := formatDuration(gone.Lifetime())
+		if gone.SeenAtStart {
+			lifetime = "at least " + lifetime
+		}
+		fmt.Fprintf(&b, "\n%s ➖ %s lived %s, %s", gone.GoneAt.Format("15:04:05"), gone.Runner.Name, lifetime, lastJob)
+	}
+	return b.String()
+}
+
+// churnSummary describes how many runners per label disappeared recently, with the hourly rate
+// The rate is taken over the time the runners were counted, which is shorter than the retention time at first
+func (m *Model) churnSummary() string {
+	parts := make([]string, 0, churnSummaryLabels)
+	for _, churn := range m.churn[:min(len(m.churn), churnSummaryLabels)] {
+		parts = append(parts, fmt.Sprintf("%s %d (%.0f/h)", churn.Label, churn.Count, churn.PerHour()))
+	}
+	return fmt.Sprintf("Churn in the last %s: %s", formatRetention(m.churn[0].Window.Round(time.Second)), strings.Join(parts, " | "))
+}
+
+// formatRetention formats the retention time without zero units, e.g. 10m instead of 10m0s
+func formatRetention(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestFormatRetention(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Duration
+		expected string
+	}{
+		{name: "minutes", input: 10 * time.Minute, expected: "10m"},
+		{name: "hours", input: 2 * time.Hour, expected: "2h"},
+		{name: "hours and minutes", input: 90 * time.Minute, expected: "1h30m"},
+		{name: "seconds", input: 30 * time.Second, expected: "30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := formatRetention(tt.input); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestGoneRunners(t *testing.T) {
+	goneAt := time.Date(2024, 1, 1, 12, 3, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		seenAtStart bool
+		window      time.Duration
+		lifetime    string
+		churn       string
+	}{
+		{
+			name:     "runner registered while monitoring",
+			window:   10 * time.Minute,
+			lifetime: "arc-abcde-runner-11111 lived 03:00, last job: build (CI)",
+			churn:    "Churn in the last 10m: arc 6 (36/h)",
+		},
+		{
+			name:        "runner registered before the monitor started",
+			seenAtStart: true,
+			window:      3 * time.Minute,
+			lifetime:    "arc-abcde-runner-11111 lived at least 03:00, last job: build (CI)",
+			churn:       "Churn in the last 3m: arc 6 (120/h)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &value_object.MonitorData{
+				CurrentTime: goneAt,
+				GoneRunners: []*value_object.GoneRunner{
+					{
+						Runner:      &entity.Runner{ID: 1, Name: "arc-abcde-runner-11111", Labels: []string{"arc"}},
+						LastJob:     &entity.Job{Name: "build", WorkflowName: "CI"},
+						FirstSeen:   goneAt.Add(-3 * time.Minute),
+						GoneAt:      goneAt,
+						SeenAtStart: tt.seenAtStart,
+					},
+				},
+				Churn: []*value_object.LabelChurn{{Label: "arc", Count: 6, Window: tt.window}},
+			}
+
+			m := NewModel(nil, "owner", "repo", "", 5, Options{})
+			m.Update(value_object.DataMsg{Data: data})
+			view := m.View()
+
+			if !strings.Contains(view, tt.lifetime) {
+				t.Errorf("expected %q in the view, got %q", tt.lifetime, view)
+			}
+			if !strings.Contains(view, tt.churn) {
+				t.Errorf("expected %q in the view, got %q", tt.churn, view)
+			}
+		})
+	}
+}
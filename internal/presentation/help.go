@@ -0,0 +1,56 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keyHelp describes a key binding shown in the help dialog
+type keyHelp struct {
+	key         string
+	description string
+}
+
+// keyBindings lists every key binding of the runner table
+var keyBindings = []keyHelp{
+	{"↑/↓, j/k", "Navigate through runners"},
+	{"enter", "Open the job log in the browser"},
+	{"v", "Show the steps of the selected runner's job"},
+	{"l", "View the log of the selected runner's job in the terminal"},
+	{"y", "Copy the job URL, run ID, runner name or a gh command to the clipboard"},
+	{"r", "Refresh"},
+	{"s", "Toggle utilization history"},
+	{"P", "Group runners into pools by scale set / name prefix, then label set"},
+	{"L", "Show/hide the capacity summary by label"},
+	{"Q", "Show/hide the queued jobs with their forecast wait"},
+	{"w", "Show/hide the runner usage by repository and workflow"},
+	{"e", "Open/close the event log"},
+	{"x", "Export the event log to a file"},
+	{"m", "Mark/unmark the selected runner"},
+	{"o", "Mark all offline runners"},
+	{"D", "Delete the marked or selected runners"},
+	{"+ / - / =", "Add / remove / set labels of the marked or selected runners"},
+	{"c / C", "Cancel / force-cancel the workflow run of the selected job"},
+	{"F", "Re-run failed jobs of the workflow run the selected runner finished last"},
+	{"R", "Re-run the job the selected runner finished last"},
+	{"p", "Pause / resume replay of a recorded session"},
+	{"[ / ]", "Step one snapshot back / forward in a replay"},
+	{"t", "Jump to a time in a replay"},
+	{"?", "Show/hide this help"},
+	{"q, ctrl+c", "Quit"},
+}
+
+// helpView renders the key binding help dialog
+func helpView() string {
+	width := 0
+	for _, binding := range keyBindings {
+		width = max(width, len([]rune(binding.key)))
+	}
+
+	lines := make([]string, 0, len(keyBindings)+2)
+	lines = append(lines, "Key Bindings", "")
+	for _, binding := range keyBindings {
+		lines = append(lines, fmt.Sprintf("%-*s  %s", width, binding.key, binding.description))
+	}
+	return dialogStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,42 @@
+package presentation
+
+import (
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestModel creates a model showing the runners and jobs, as if the first refresh had completed
+func newTestModel(opts Options, runners []*entity.Runner, jobs []*entity.Job) *Model {
+	m := NewModel(nil, "owner", "repo", "", 5, opts)
+	m.runners = runners
+	m.jobs = jobs
+	m.loading = false
+	m.updateTableRows()
+	return m
+}
+
+// newBuildModel creates a model in which runner-1 runs the build job of run 100 and runner-2 is idle
+func newBuildModel(opts Options) *Model {
+	runnerID := int64(1)
+	return newTestModel(opts,
+		[]*entity.Runner{
+			{ID: 1, Name: "runner-1", Status: entity.StatusActive},
+			{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+		},
+		[]*entity.Job{
+			{ID: 10, RunID: 100, Name: "build", WorkflowName: "CI", Status: "in_progress", RunnerID: &runnerID,
+				Repository: "owner/repo", HtmlUrl: "https://github.com/owner/repo/actions/runs/100/job/10"},
+		},
+	)
+}
+
+// key returns the message of a key press, given as a single character, "enter" or "esc"
+func key(k string) tea.KeyMsg {
+	switch k {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
@@ -0,0 +1,125 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+// Sparkline glyphs used to render utilization ratios, from lowest to highest
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// ringBuffer is a fixed-capacity buffer that keeps the most recent values
+type ringBuffer[T any] struct {
+	values []T
+	next   int
+	full   bool
+}
+
+// newRingBuffer creates a ring buffer holding at most capacity values
+func newRingBuffer[T any](capacity int) *ringBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &ringBuffer[T]{
+		values: make([]T, capacity),
+	}
+}
+
+// push appends a value, overwriting the oldest one when the buffer is full
+func (r *ringBuffer[T]) push(v T) {
+	r.values[r.next] = v
+	r.next = (r.next + 1) % len(r.values)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// items returns the stored values ordered from oldest to newest
+func (r *ringBuffer[T]) items() []T {
+	if !r.full {
+		return append([]T(nil), r.values[:r.next]...)
+	}
+	return append(append([]T(nil), r.values[r.next:]...), r.values[:r.next]...)
+}
+
+// recordHistory appends the current snapshot to the per-runner and fleet histories
+func (m *Model) recordHistory() {
+	if m.runnerHistory == nil {
+		m.runnerHistory = make(map[int64]*ringBuffer[entity.RunnerStatus])
+	}
+	if m.fleetHistory == nil {
+		m.fleetHistory = newRingBuffer[float64](m.historySize)
+	}
+
+	seen := make(map[int64]bool, len(m.runners))
+	for _, runner := range m.runners {
+		seen[runner.ID] = true
+		history, ok := m.runnerHistory[runner.ID]
+		if !ok {
+			history = newRingBuffer[entity.RunnerStatus](m.historySize)
+			m.runnerHistory[runner.ID] = history
+		}
+		history.push(runner.Status)
+	}
+
+	// Forget runners that are no longer registered
+	for id := range m.runnerHistory {
+		if !seen[id] {
+			delete(m.runnerHistory, id)
+		}
+	}
+
+	m.fleetHistory.push(calculateUtilization(m.runners))
+}
+
+// calculateUtilization returns the ratio of busy runners to online runners
+func calculateUtilization(runners []*entity.Runner) float64 {
+	online := 0
+	active := 0
+	for _, runner := range runners {
+		if runner.IsOnline() {
+			online++
+		}
+		if runner.IsBusy() {
+			active++
+		}
+	}
+	if online == 0 {
+		return 0
+	}
+	return float64(active) / float64(online)
+}
+
+// formatStatusStrip renders a heat strip of runner statuses, one glyph per refresh
+func formatStatusStrip(statuses []entity.RunnerStatus) string {
+	if len(statuses) == 0 {
+		return "-"
+	}
+	var b strings.Builder
+	for _, status := range statuses {
+		switch status {
+		case entity.StatusActive:
+			b.WriteRune('█')
+		case entity.StatusBusy:
+			b.WriteRune('▓')
+		case entity.StatusIdle:
+			b.WriteRune('▁')
+		case entity.StatusOffline:
+			b.WriteRune('·')
+		default:
+			b.WriteRune('?')
+		}
+	}
+	return b.String()
+}
+
+// formatSparkline renders ratios in the range [0, 1] as a sparkline
+func formatSparkline(ratios []float64) string {
+	var b strings.Builder
+	for _, ratio := range ratios {
+		ratio = min(max(ratio, 0), 1)
+		b.WriteRune(sparklineLevels[int(ratio*float64(len(sparklineLevels)-1)+0.5)])
+	}
+	return b.String()
+}
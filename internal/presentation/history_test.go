@@ -0,0 +1,102 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+)
+
+func TestRingBuffer(t *testing.T) {
+	t.Run("keeps values in insertion order before wrapping", func(t *testing.T) {
+		r := newRingBuffer[int](3)
+		r.push(1)
+		r.push(2)
+
+		items := r.items()
+		if len(items) != 2 || items[0] != 1 || items[1] != 2 {
+			t.Errorf("expected [1 2], got %v", items)
+		}
+	})
+
+	t.Run("overwrites oldest values when full", func(t *testing.T) {
+		r := newRingBuffer[int](3)
+		for i := 1; i <= 5; i++ {
+			r.push(i)
+		}
+
+		items := r.items()
+		expected := []int{3, 4, 5}
+		if len(items) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, items)
+		}
+		for i := range expected {
+			if items[i] != expected[i] {
+				t.Errorf("expected %v, got %v", expected, items)
+				break
+			}
+		}
+	})
+}
+
+func TestRecordHistory(t *testing.T) {
+	model := &Model{historySize: 3}
+
+	model.runners = []*entity.Runner{
+		{ID: 1, Status: entity.StatusActive},
+		{ID: 2, Status: entity.StatusIdle},
+	}
+	model.recordHistory()
+
+	model.runners = []*entity.Runner{
+		{ID: 1, Status: entity.StatusIdle},
+	}
+	model.recordHistory()
+
+	if _, ok := model.runnerHistory[2]; ok {
+		t.Error("expected history of removed runner to be dropped")
+	}
+
+	if strip := formatStatusStrip(model.runnerHistory[1].items()); strip != "█▁" {
+		t.Errorf("expected strip '█▁', got %s", strip)
+	}
+
+	ratios := model.fleetHistory.items()
+	if len(ratios) != 2 || ratios[0] != 0.5 || ratios[1] != 0 {
+		t.Errorf("expected fleet utilization [0.5 0], got %v", ratios)
+	}
+}
+
+func TestFormatStatusStrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []entity.RunnerStatus
+		expected string
+	}{
+		{
+			name:     "no history",
+			statuses: nil,
+			expected: "-",
+		},
+		{
+			name:     "mixed statuses",
+			statuses: []entity.RunnerStatus{entity.StatusIdle, entity.StatusActive, entity.StatusBusy, entity.StatusOffline},
+			expected: "▁█▓·",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := formatStatusStrip(tt.statuses)
+			if result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFormatSparkline(t *testing.T) {
+	result := formatSparkline([]float64{0, 0.5, 1, 2})
+	if result != "▁▅██" {
+		t.Errorf("expected ▁▅██, got %s", result)
+	}
+}
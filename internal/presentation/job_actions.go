@@ -0,0 +1,106 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// selectedJob returns the job assigned to the selected runner, or nil if there is none
+func (m *Model) selectedJob() *entity.Job {
+	runner := m.selectedRunner()
+	if runner == nil {
+		return nil
+	}
+	return m.getJobIndex().ByRunnerID(runner.ID)
+}
+
+// recordFinishedJobs remembers, per runner, the last job that left the snapshot, so that it can be re-run
+// It must be called before the model takes the new snapshot; snapshots missing some jobs are skipped
+func (m *Model) recordFinishedJobs(data *value_object.MonitorData) {
+	if data.JobsIncomplete {
+		return
+	}
+
+	current := make(map[int64]bool, len(data.Jobs))
+	for _, job := range data.Jobs {
+		current[job.ID] = true
+	}
+	present := make(map[int64]bool, len(data.Runners))
+	for _, runner := range data.Runners {
+		present[runner.ID] = true
+	}
+
+	index := m.getJobIndex()
+	for _, runner := range m.runners {
+		if job := index.ByRunnerID(runner.ID); job != nil && !current[job.ID] {
+			m.finishedJobs[runner.ID] = job
+		}
+	}
+	for id := range m.finishedJobs {
+		if !present[id] {
+			delete(m.finishedJobs, id)
+		}
+	}
+}
+
+// jobActionTarget returns the job the action applies to
+// Cancelling targets the running job, while GitHub only re-runs completed jobs, so re-runs target the job
+// the selected runner finished last
+func (m *Model) jobActionTarget(action usecase.JobAction) (*entity.Job, string) {
+	switch action {
+	case usecase.JobActionRerunFailedJobs, usecase.JobActionRerunJob:
+		runner := m.selectedRunner()
+		if runner == nil || m.finishedJobs[runner.ID] == nil {
+			return nil, "The selected runner has not finished a job since monitoring started"
+		}
+		return m.finishedJobs[runner.ID], ""
+	default:
+		job := m.selectedJob()
+		if job == nil {
+			return nil, "No job is assigned to the selected runner"
+		}
+		return job, ""
+	}
+}
+
+// confirmJobAction asks for confirmation before running an action on the selected runner's job
+func (m *Model) confirmJobAction(action usecase.JobAction) tea.Cmd {
+	if m.jobManager == nil {
+		return m.setStatusMessage("Job actions are not available")
+	}
+	if m.jobManager.IsReadOnly() {
+		return m.setStatusMessage("Read-only mode: job actions are disabled")
+	}
+	job, reason := m.jobActionTarget(action)
+	if job == nil {
+		return m.setStatusMessage(reason)
+	}
+
+	prompt := fmt.Sprintf("%s (%s, run %d)?", describeJobAction(action, job), job.WorkflowName, job.RunID)
+	m.confirm(prompt, func() tea.Msg {
+		err := m.jobManager.Execute(context.Background(), action, m.owner, m.repo, job)
+		return actionResultMsg{message: fmt.Sprintf("Requested: %s", describeJobAction(action, job)), err: err}
+	})
+	return nil
+}
+
+// describeJobAction returns a short description of the action on the job
+func describeJobAction(action usecase.JobAction, job *entity.Job) string {
+	switch action {
+	case usecase.JobActionCancel:
+		return fmt.Sprintf("Cancel the workflow run of %s", job.Name)
+	case usecase.JobActionForceCancel:
+		return fmt.Sprintf("Force-cancel the workflow run of %s", job.Name)
+	case usecase.JobActionRerunFailedJobs:
+		return fmt.Sprintf("Re-run failed jobs in the workflow run of %s", job.Name)
+	case usecase.JobActionRerunJob:
+		return fmt.Sprintf("Re-run job %s", job.Name)
+	default:
+		return fmt.Sprintf("%s %s", action, job.Name)
+	}
+}
@@ -0,0 +1,132 @@
+package presentation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+func TestJobActions(t *testing.T) {
+	runnerID := int64(1)
+	newModel := func(jobRepo *test.StubJobRepository, readOnly bool) *Model {
+		return newBuildModel(Options{JobManager: usecase.NewJobManager(jobRepo, readOnly)})
+	}
+	// finishJob delivers a snapshot in which the build job has finished and runner-1 started another job
+	finishJob := func(m *Model) {
+		m.Update(value_object.DataMsg{Data: &value_object.MonitorData{
+			Runners: m.runners,
+			Jobs: []*entity.Job{
+				{ID: 11, RunID: 101, Name: "test", Status: "in_progress", RunnerID: &runnerID, Repository: "owner/repo"},
+			},
+		}})
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		finished bool
+		method   string
+		id       int64
+	}{
+		{name: "cancel", key: "c", method: "CancelWorkflowRun", id: 100},
+		{name: "force-cancel", key: "C", method: "ForceCancelWorkflowRun", id: 100},
+		{name: "rerun failed jobs", key: "F", finished: true, method: "RerunFailedJobs", id: 100},
+		{name: "rerun job", key: "R", finished: true, method: "RerunJob", id: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" after confirmation", func(t *testing.T) {
+			jobRepo := &test.StubJobRepository{}
+			m := newModel(jobRepo, false)
+			if tt.finished {
+				finishJob(m)
+			}
+
+			m.Update(key(tt.key))
+			if m.mode != modeConfirm {
+				t.Fatal("expected confirmation dialog")
+			}
+
+			_, cmd := m.Update(key("y"))
+			if cmd == nil {
+				t.Fatal("expected action command")
+			}
+			msg, ok := cmd().(actionResultMsg)
+			if !ok || msg.err != nil {
+				t.Fatalf("unexpected result %+v", msg)
+			}
+
+			if len(jobRepo.JobActionCalls) != 1 {
+				t.Fatalf("expected one call, got %+v", jobRepo.JobActionCalls)
+			}
+			call := jobRepo.JobActionCalls[0]
+			if call.Method != tt.method || call.ID != tt.id || call.Owner != "owner" || call.Repo != "repo" {
+				t.Errorf("unexpected call %+v", call)
+			}
+
+			m.Update(msg)
+			events := m.eventLog
+			if len(events) != 1 || events[0].Type != value_object.EventAction {
+				t.Errorf("expected the action to be logged, got %v", events)
+			}
+		})
+	}
+
+	t.Run("runner without a job does not open the dialog", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{}
+		m := newModel(jobRepo, false)
+		m.table.SetCursor(1)
+
+		m.Update(key("c"))
+
+		if m.mode != modeNormal {
+			t.Error("expected no dialog without a job")
+		}
+		if m.statusMessage == "" {
+			t.Error("expected a status message")
+		}
+	})
+
+	t.Run("re-running needs a finished job", func(t *testing.T) {
+		for _, k := range []string{"F", "R"} {
+			m := newModel(&test.StubJobRepository{}, false)
+
+			m.Update(key(k))
+
+			if m.mode != modeNormal {
+				t.Errorf("expected no dialog for %s while the job is running", k)
+			}
+			if m.statusMessage == "" {
+				t.Errorf("expected a status message for %s", k)
+			}
+		}
+	})
+
+	t.Run("snapshots missing jobs do not finish them", func(t *testing.T) {
+		m := newModel(&test.StubJobRepository{}, false)
+
+		m.Update(value_object.DataMsg{Data: &value_object.MonitorData{
+			Runners:        m.runners,
+			JobsIncomplete: true,
+			Warnings:       []error{errors.New("failed to fetch jobs")},
+		}})
+
+		if len(m.finishedJobs) != 0 {
+			t.Errorf("expected no finished jobs, got %v", m.finishedJobs)
+		}
+	})
+
+	t.Run("read-only mode does not open the dialog", func(t *testing.T) {
+		m := newModel(&test.StubJobRepository{}, true)
+
+		m.Update(key("R"))
+
+		if m.mode != modeNormal {
+			t.Error("expected no dialog in read-only mode")
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Step status icons shown in the job detail view
+const (
+	stepIconSuccess = "✓"
+	stepIconFailure = "✗"
+	stepIconSkipped = "-"
+	stepIconRunning = "▶"
+	stepIconPending = "·"
+)
+
+// openJobDetail shows the job of the selected runner with its steps in place of the runner table
+func (m *Model) openJobDetail() tea.Cmd {
+	runner := m.selectedRunner()
+	if runner == nil || m.getJobIndex().ByRunnerID(runner.ID) == nil {
+		return m.setStatusMessage("No job is assigned to the selected runner")
+	}
+	m.detailRunnerID = runner.ID
+	m.setView(viewDetail)
+	return nil
+}
+
+// detailJob returns the runner shown in the detail view and its current job, nil when it is gone or idle
+func (m *Model) detailJob() (*entity.Runner, *entity.Job) {
+	for _, runner := range m.runners {
+		if runner.ID == m.detailRunnerID {
+			return runner, m.getJobIndex().ByRunnerID(runner.ID)
+		}
+	}
+	return nil, nil
+}
+
+// updateDetailKeys handles the keys of the job detail and returns true if the key was handled
+func (m *Model) updateDetailKeys(key string) (tea.Cmd, bool) {
+	switch key {
+	case "v":
+		m.setView(viewTable)
+		return nil, true
+	case "l":
+		_, job := m.detailJob()
+		return m.openJobLogView(job), true
+	}
+	return nil, false
+}
+
+// detailView renders the job detail in place of the runner table
+func (m *Model) detailView() string {
+	return m.viewportView("Job Details | 'l' to view the log, 'v'/'esc' to close, ↑/↓ to scroll")
+}
+
+// detailContent renders the job of the runner followed by one aligned line per step
+func (m *Model) detailContent() string {
+	runner, job := m.detailJob()
+	if runner == nil {
+		return "The runner is gone"
+	}
+	if job == nil {
+		return fmt.Sprintf("No job is assigned to %s", runner.Name)
+	}
+
+	lines := []string{
+		fmt.Sprintf("Runner:     %s", runner.Name),
+		fmt.Sprintf("Job:        %s", job.Name),
+		fmt.Sprintf("Workflow:   %s (run %d)", job.WorkflowName, job.RunID),
+		fmt.Sprintf("Repository: %s", job.Repository),
+		fmt.Sprintf("Status:     %s, %s", job.Status, formatDuration(job.GetExecutionDurationAt(m.currentTime))),
+		fmt.Sprintf("URL:        %s", job.HtmlUrl),
+		"",
+	}
+	if len(job.Steps) == 0 {
+		return strings.Join(append(lines, "No steps reported yet"), "\n")
+	}
+
+	numberWidth := len(fmt.Sprint(len(job.Steps)))
+	nameWidth := len("Step")
+	for _, step := range job.Steps {
+		nameWidth = max(nameWidth, len([]rune(step.Name)))
+	}
+	lines = append(lines, fmt.Sprintf("%*s  %-*s  %-11s  %s", numberWidth+2, "#", nameWidth, "Step", "Status", "Duration"))
+	for i, step := range job.Steps {
+		duration := "-"
+		if step.StartedAt != nil {
+			duration = formatDuration(step.GetDurationAt(m.currentTime))
+		}
+		lines = append(lines, fmt.Sprintf("%s %*d  %-*s  %-11s  %s",
+			getStepIcon(step), numberWidth, i+1, nameWidth, step.Name, formatStepStatus(step), duration))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatJobStep formats the position and name of the step the job is at, e.g. "3/12 Run tests"
+// It returns an empty string when the job reported no steps
+func formatJobStep(job *entity.Job) string {
+	position, step := job.CurrentStep()
+	if step == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d %s", position, len(job.Steps), step.Name)
+}
+
+// formatStepStatus returns the conclusion of a completed step, otherwise its status
+func formatStepStatus(step *entity.JobStep) string {
+	if step.IsCompleted() && step.Conclusion != "" {
+		return step.Conclusion
+	}
+	return step.Status
+}
+
+// getStepIcon returns an icon for the status of a step
+func getStepIcon(step *entity.JobStep) string {
+	switch {
+	case step.IsRunning():
+		return stepIconRunning
+	case !step.IsCompleted():
+		return stepIconPending
+	case step.Conclusion == "success":
+		return stepIconSuccess
+	case step.Conclusion == "skipped":
+		return stepIconSkipped
+	default:
+		return stepIconFailure
+	}
+}
@@ -0,0 +1,70 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestJobStepProgress(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	runnerID := int64(1)
+	job := &entity.Job{
+		ID: 100, RunID: 10, Name: "build", WorkflowName: "CI", Repository: "owner/repo", Status: "in_progress",
+		RunnerID: &runnerID, StartedAt: at(-3 * time.Minute),
+		Steps: []*entity.JobStep{
+			{Number: 1, Name: "Set up job", Status: "completed", Conclusion: "success", StartedAt: at(-3 * time.Minute), CompletedAt: at(-170 * time.Second)},
+			{Number: 2, Name: "Lint", Status: "completed", Conclusion: "skipped"},
+			{Number: 3, Name: "Run tests", Status: "in_progress", StartedAt: at(-170 * time.Second)},
+			{Number: 4, Name: "Complete job", Status: "queued"},
+		},
+	}
+	data := &value_object.MonitorData{
+		CurrentTime: now,
+		Runners: []*entity.Runner{
+			{ID: 1, Name: "runner-1", Status: entity.StatusActive},
+			{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+		},
+		Jobs: []*entity.Job{job},
+	}
+	pressV := key("v")
+
+	m := NewModel(nil, "owner", "repo", "", 5, Options{})
+	m.Update(value_object.DataMsg{Data: data})
+
+	if rows := m.table.Rows(); rows[0][3] != "build (CI) · 3/4 Run tests" {
+		t.Errorf("expected the current step in the job column, got %q", rows[0][3])
+	}
+
+	m.Update(pressV)
+	if m.view != viewDetail {
+		t.Fatal("expected the job detail to be shown")
+	}
+	view := m.View()
+	for _, expected := range []string{"Job Details", "CI (run 10)", "✓ 1  Set up job", "- 2  Lint", "▶ 3  Run tests     in_progress  02:50", "· 4  Complete job  queued       -"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("expected %q in the job detail, got %q", expected, view)
+		}
+	}
+
+	m.Update(pressV)
+	if m.view != viewTable || !m.table.Focused() {
+		t.Error("expected the runner table to be shown again")
+	}
+
+	m.table.SetCursor(1)
+	m.Update(pressV)
+	if m.view == viewDetail {
+		t.Error("expected no job detail for an idle runner")
+	}
+	if m.statusMessage != "No job is assigned to the selected runner" {
+		t.Errorf("expected a status message for an idle runner, got %q", m.statusMessage)
+	}
+}
@@ -0,0 +1,267 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// logMatchStyle highlights the log lines matching the search
+	logMatchStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("229"))
+	// logCurrentMatchStyle highlights the log line the search is at
+	logCurrentMatchStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+)
+
+// jobLogMsg carries a downloaded job log
+// generation identifies the log view it was requested for, and manual is set for downloads requested with 'r',
+// which do not continue the periodic refresh
+type jobLogMsg struct {
+	generation int
+	manual     bool
+	log        string
+	err        error
+}
+
+// jobLogTickMsg triggers the periodic refresh of the log of a running job
+// Ticks of a log view that was closed or reopened since carry an older generation and are ignored
+type jobLogTickMsg struct {
+	generation int
+}
+
+// openJobLogView downloads the log of the job and shows it in place of the current view, which closing the log returns to
+func (m *Model) openJobLogView(job *entity.Job) tea.Cmd {
+	if job == nil {
+		return m.setStatusMessage("No job is assigned to the selected runner")
+	}
+	if m.jobManager == nil {
+		return m.setStatusMessage("Job logs are not available")
+	}
+
+	m.logReturnView = m.view
+	m.setView(viewLog)
+	m.logJob = job
+	m.logText = ""
+	m.logLines = nil
+	m.logErr = nil
+	m.logLive = false
+	m.logSearch = ""
+	m.logMatches = nil
+	m.logGeneration++
+	m.viewport.SetContent("Downloading the log...")
+	return m.fetchJobLog(false)
+}
+
+// fetchJobLog downloads the log of the shown job
+func (m *Model) fetchJobLog(manual bool) tea.Cmd {
+	job, generation := m.logJob, m.logGeneration
+	return func() tea.Msg {
+		log, err := m.jobManager.FetchLog(context.Background(), m.owner, m.repo, job)
+		return jobLogMsg{generation: generation, manual: manual, log: log, err: err}
+	}
+}
+
+// applyJobLog shows a downloaded log and schedules the next refresh while the job is running
+// The view keeps following the end of the log unless it was scrolled up
+func (m *Model) applyJobLog(msg jobLogMsg) tea.Cmd {
+	if m.view != viewLog || msg.generation != m.logGeneration {
+		return nil
+	}
+
+	follow := m.logLines == nil || m.viewport.AtBottom()
+	m.logErr = msg.err
+	if msg.err == nil {
+		m.setJobLog(msg.log)
+		m.logMatch = min(m.logMatch, max(len(m.logMatches)-1, 0))
+	}
+	m.renderJobLog()
+	if follow && m.logLines != nil {
+		m.viewport.GotoBottom()
+	}
+	if msg.manual {
+		return nil
+	}
+
+	// Refresh once more after the job finished to pick up the end of the log
+	running := m.isJobRunning(m.logJob.ID)
+	refresh := running || m.logLive
+	m.logLive = running
+	if !refresh {
+		return nil
+	}
+	return tea.Tick(m.updateInterval, func(time.Time) tea.Msg {
+		return jobLogTickMsg{generation: msg.generation}
+	})
+}
+
+// setJobLog splits the log into lines and searches them
+// The log of a running job grows at the end, so only the lines changed since the previous download are processed
+func (m *Model) setJobLog(log string) {
+	log = strings.TrimRight(strings.ReplaceAll(log, "\r\n", "\n"), "\n")
+	if m.logLines == nil || !strings.HasPrefix(log, m.logText) {
+		m.logText = log
+		m.logLines = strings.Split(log, "\n")
+		m.logMatches = findLogMatches(m.logLines, m.logSearch)
+		return
+	}
+
+	// The last line may have been incomplete, so it is split again with the new text
+	last := len(m.logLines) - 1
+	added := strings.Split(m.logLines[last]+log[len(m.logText):], "\n")
+	m.logText = log
+	m.logLines = append(m.logLines[:last], added...)
+	m.logMatches = slices.DeleteFunc(m.logMatches, func(line int) bool { return line >= last })
+	for _, line := range findLogMatches(added, m.logSearch) {
+		m.logMatches = append(m.logMatches, last+line)
+	}
+}
+
+// refreshJobLog downloads the log again if the view the tick was scheduled for is still shown
+func (m *Model) refreshJobLog(msg jobLogTickMsg) tea.Cmd {
+	if m.view != viewLog || msg.generation != m.logGeneration {
+		return nil
+	}
+	return m.fetchJobLog(false)
+}
+
+// isJobRunning returns true if the job is running in the current snapshot
+func (m *Model) isJobRunning(jobID int64) bool {
+	for _, job := range m.jobs {
+		if job.ID == jobID {
+			return job.IsRunning()
+		}
+	}
+	return false
+}
+
+// updateJobLogKeys handles the keys of the job log and returns true if the key was handled
+func (m *Model) updateJobLogKeys(key string) (tea.Cmd, bool) {
+	switch key {
+	case "l", "esc":
+		m.setView(m.logReturnView)
+		return nil, true
+	case "r":
+		return m.fetchJobLog(true), true
+	case "/":
+		return m.startLogSearchInput(), true
+	case "n":
+		m.moveLogMatch(1)
+		return nil, true
+	case "N":
+		m.moveLogMatch(-1)
+		return nil, true
+	}
+	return nil, false
+}
+
+// startLogSearchInput opens the prompt for the text to search the log for
+func (m *Model) startLogSearchInput() tea.Cmd {
+	m.textInput = textinput.New()
+	m.textInput.Placeholder = "text to search for"
+	m.textInput.Prompt = "Search: "
+	m.textInput.SetValue(m.logSearch)
+	m.mode = modeLogSearchInput
+	return m.textInput.Focus()
+}
+
+// cancelLogSearchInput closes the search prompt and returns to the log
+func (m *Model) cancelLogSearchInput() {
+	m.cancelInput()
+	m.table.Blur()
+}
+
+// submitLogSearchInput searches the log and moves to the first match below the top of the view
+func (m *Model) submitLogSearchInput() tea.Cmd {
+	m.logSearch = m.textInput.Value()
+	m.cancelLogSearchInput()
+
+	m.logMatches = findLogMatches(m.logLines, m.logSearch)
+	if m.logSearch != "" && len(m.logMatches) == 0 {
+		m.renderJobLog()
+		return m.setStatusMessage(fmt.Sprintf("No match for %q", m.logSearch))
+	}
+	m.logMatch = 0
+	if i := slices.IndexFunc(m.logMatches, func(line int) bool { return line >= m.viewport.YOffset }); i >= 0 {
+		m.logMatch = i
+	}
+	m.renderJobLog()
+	m.scrollToLogMatch()
+	return nil
+}
+
+// moveLogMatch moves to the next or previous match, wrapping around at the ends
+func (m *Model) moveLogMatch(delta int) {
+	if len(m.logMatches) == 0 {
+		return
+	}
+	m.logMatch = (m.logMatch + delta + len(m.logMatches)) % len(m.logMatches)
+	m.renderJobLog()
+	m.scrollToLogMatch()
+}
+
+// scrollToLogMatch scrolls the current match into the middle of the view
+func (m *Model) scrollToLogMatch() {
+	if len(m.logMatches) == 0 {
+		return
+	}
+	m.viewport.SetYOffset(m.logMatches[m.logMatch] - m.viewport.Height/2)
+}
+
+// renderJobLog sets the log with the search matches highlighted as the viewport content
+func (m *Model) renderJobLog() {
+	if m.logErr != nil && m.logLines == nil {
+		m.viewport.SetContent(fmt.Sprintf("Failed to download the log: %s", firstLine(m.logErr)))
+		return
+	}
+
+	lines := slices.Clone(m.logLines)
+	for i, line := range m.logMatches {
+		if i == m.logMatch {
+			lines[line] = logCurrentMatchStyle.Render(lines[line])
+		} else {
+			lines[line] = logMatchStyle.Render(lines[line])
+		}
+	}
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// jobLogView renders the job log in place of the runner table
+func (m *Model) jobLogView() string {
+	title := fmt.Sprintf("Job Log: %s (%s)", m.logJob.Name, m.logJob.WorkflowName)
+	if m.logLive {
+		title += fmt.Sprintf(", refreshed every %s", m.updateInterval)
+	}
+	if m.logSearch != "" {
+		position := 0
+		if len(m.logMatches) > 0 {
+			position = m.logMatch + 1
+		}
+		title += fmt.Sprintf(" | /%s %d/%d", m.logSearch, position, len(m.logMatches))
+	}
+	if m.logErr != nil && m.logLines != nil {
+		title += fmt.Sprintf(" | Error: %s", firstLine(m.logErr))
+	}
+	return m.viewportView(title + " | '/' search, 'n'/'N' next/previous, 'l'/'esc' to close")
+}
+
+// findLogMatches returns the indexes of the lines containing the query, ignoring case
+func findLogMatches(lines []string, query string) []int {
+	if query == "" {
+		return nil
+	}
+	query = strings.ToLower(query)
+	var matches []int
+	for i, line := range lines {
+		if strings.Contains(strings.ToLower(line), query) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
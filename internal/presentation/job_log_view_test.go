@@ -0,0 +1,191 @@
+package presentation
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestJobLogView(t *testing.T) {
+	newModel := func(jobRepo *test.StubJobRepository) *Model {
+		return newBuildModel(Options{JobManager: usecase.NewJobManager(jobRepo, true)})
+	}
+	// press sends the key; the log download started by 'l' is fed back like the program loop does
+	press := func(m *Model, k string) tea.Cmd {
+		_, cmd := m.Update(key(k))
+		if k != "l" || cmd == nil {
+			return cmd
+		}
+		if msg, ok := cmd().(jobLogMsg); ok {
+			_, cmd = m.Update(msg)
+		}
+		return cmd
+	}
+
+	t.Run("shows the log and keeps refreshing while the job runs", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{Log: "Run tests\r\nok\r\n"}
+		m := newModel(jobRepo)
+
+		if cmd := press(m, "l"); cmd == nil {
+			t.Error("expected a refresh to be scheduled for a running job")
+		}
+		if m.view != viewLog || m.table.Focused() {
+			t.Fatal("expected the job log to be shown")
+		}
+		if call := jobRepo.FetchJobLogCalls[0]; call.ID != 10 {
+			t.Errorf("expected the log of job 10, got %d", call.ID)
+		}
+		view := m.View()
+		if !strings.Contains(view, "Job Log: build (CI), refreshed every 5s") || !strings.Contains(view, "\nRun tests ") || !strings.Contains(view, "\nok ") {
+			t.Errorf("expected the job log, got %q", view)
+		}
+
+		// The job finished: one more download, then the refresh stops
+		m.jobs = nil
+		_, cmd := m.Update(jobLogTickMsg{generation: m.logGeneration})
+		if _, cmd = m.Update(cmd()); cmd == nil {
+			t.Error("expected one more refresh after the job finished")
+		}
+		_, cmd = m.Update(jobLogTickMsg{generation: m.logGeneration})
+		if _, cmd = m.Update(cmd()); cmd != nil {
+			t.Error("expected no refresh once the end of the log was downloaded")
+		}
+
+		press(m, "esc")
+		if m.view != viewTable || !m.table.Focused() {
+			t.Error("expected the runner table to be shown again")
+		}
+	})
+
+	t.Run("keeps a single refresh loop", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{Log: "Run tests\n"}
+		m := newModel(jobRepo)
+		press(m, "l")
+		stale := m.logGeneration
+
+		// A manual refresh shows the new log without starting another loop
+		_, cmd := m.Update(key("r"))
+		if _, cmd = m.Update(cmd()); cmd != nil {
+			t.Error("expected no refresh to be scheduled by 'r'")
+		}
+
+		// Reopening the log drops the ticks of the previous view
+		press(m, "esc")
+		press(m, "l")
+		if _, cmd = m.Update(jobLogTickMsg{generation: stale}); cmd != nil {
+			t.Error("expected the tick of the closed view to be ignored")
+		}
+		if _, cmd = m.Update(jobLogMsg{generation: stale, log: "old"}); cmd != nil || m.logLines[0] != "Run tests" {
+			t.Errorf("expected the log of the closed view to be ignored, got %v", m.logLines)
+		}
+		if _, cmd = m.Update(jobLogTickMsg{generation: m.logGeneration}); cmd == nil {
+			t.Error("expected the tick of the shown view to refresh the log")
+		}
+	})
+
+	t.Run("searches the log", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{Log: "setup\nFAIL: a\npass\nfail: b\n"}
+		m := newModel(jobRepo)
+		press(m, "l")
+
+		press(m, "/")
+		if m.mode != modeLogSearchInput {
+			t.Fatal("expected the search prompt")
+		}
+		m.textInput.SetValue("fail")
+		press(m, "enter")
+
+		if len(m.logMatches) != 2 || m.logMatches[0] != 1 || m.logMatches[1] != 3 {
+			t.Fatalf("expected matches on lines 1 and 3, got %v", m.logMatches)
+		}
+		if !strings.Contains(m.View(), "/fail 1/2") {
+			t.Errorf("expected the search position in the title, got %q", m.View())
+		}
+		press(m, "n")
+		press(m, "n")
+		if m.logMatch != 0 {
+			t.Errorf("expected the search to wrap around to the first match, got %d", m.logMatch)
+		}
+		press(m, "N")
+		if m.logMatch != 1 {
+			t.Errorf("expected the search to wrap around to the last match, got %d", m.logMatch)
+		}
+		if m.view != viewLog || m.table.Focused() {
+			t.Error("expected the job log to stay shown after searching")
+		}
+	})
+
+	t.Run("splits only the lines added to a growing log", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{Log: "setup\nFAIL: a\nrunn"}
+		m := newModel(jobRepo)
+		press(m, "l")
+		m.logSearch = "fail"
+		m.logMatches = findLogMatches(m.logLines, m.logSearch)
+
+		m.Update(jobLogMsg{generation: m.logGeneration, manual: true, log: "setup\nFAIL: a\nrunning\nfail: b\n"})
+
+		if expected := []string{"setup", "FAIL: a", "running", "fail: b"}; !slices.Equal(m.logLines, expected) {
+			t.Errorf("expected lines %q, got %q", expected, m.logLines)
+		}
+		if len(m.logMatches) != 2 || m.logMatches[0] != 1 || m.logMatches[1] != 3 {
+			t.Errorf("expected matches on lines 1 and 3, got %v", m.logMatches)
+		}
+
+		// A log that does not continue the previous one is split again
+		m.Update(jobLogMsg{generation: m.logGeneration, manual: true, log: "fail: c\n"})
+		if len(m.logLines) != 1 || len(m.logMatches) != 1 || m.logMatches[0] != 0 {
+			t.Errorf("expected the new log only, got %q with matches %v", m.logLines, m.logMatches)
+		}
+	})
+
+	t.Run("returns to the view it was opened from", func(t *testing.T) {
+		m := newModel(&test.StubJobRepository{Log: "Run tests\n"})
+		m.Update(key("v"))
+		if m.view != viewDetail {
+			t.Fatal("expected the job detail to be shown")
+		}
+
+		press(m, "l")
+		if m.view != viewLog {
+			t.Fatal("expected the job log to be shown")
+		}
+		press(m, "l")
+		if m.view != viewDetail {
+			t.Errorf("expected 'l' to return to the job detail, got view %d", m.view)
+		}
+
+		press(m, "l")
+		press(m, "esc")
+		if m.view != viewDetail {
+			t.Errorf("expected 'esc' to return to the job detail, got view %d", m.view)
+		}
+	})
+
+	t.Run("reports download errors", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{FetchJobLogError: errors.New("HTTP 404: Not Found")}
+		m := newModel(jobRepo)
+		press(m, "l")
+
+		if !strings.Contains(m.View(), "Failed to download the log: HTTP 404: Not Found") {
+			t.Errorf("expected the download error, got %q", m.View())
+		}
+	})
+
+	t.Run("needs a job", func(t *testing.T) {
+		m := newModel(&test.StubJobRepository{})
+		m.table.SetCursor(1)
+		m.Update(key("l"))
+
+		if m.view == viewLog {
+			t.Error("expected no job log for an idle runner")
+		}
+		if m.statusMessage != "No job is assigned to the selected runner" {
+			t.Errorf("expected a status message for an idle runner, got %q", m.statusMessage)
+		}
+	})
+}
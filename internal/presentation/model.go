@@ -1,12 +1,16 @@
 package presentation
 
 import (
+	"io"
 	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -18,6 +22,8 @@ const (
 	columnTitleLabels        = "Labels"
 	columnTitleJobName       = "Job Name"
 	columnTitleExecutionTime = "Time"
+	columnTitleHistory       = "History"
+	columnTitleLabel         = "Label"
 )
 
 // Column width constants
@@ -27,8 +33,11 @@ const (
 	minLabelsWidth     = 10
 	minJobNameWidth    = 10
 
-	statusWidth   = 10
-	execTimeWidth = 10
+	statusWidth   = 12
+	execTimeWidth = 12
+
+	// Minimum width of the optional history column, enough for its title
+	minHistoryWidth = len(columnTitleHistory)
 
 	// Space reserved for borders and padding
 	borderPadding = 10
@@ -44,8 +53,31 @@ const (
 	// Default terminal size (fallback if WindowSizeMsg is not received)
 	defaultTerminalWidth  = 80
 	defaultTerminalHeight = 24
+
+	// Default number of refreshes kept for the utilization history
+	DefaultHistorySize = 20
 )
 
+// Options holds optional settings for the TUI model
+type Options struct {
+	// HistorySize is the number of refreshes kept for the utilization sparklines
+	HistorySize int
+	// ShowHistory shows the utilization sparklines on startup
+	ShowHistory bool
+	// Notifications configures terminal notifications for runner and job transitions
+	Notifications NotificationOptions
+	// RunnerManager enables runner management actions when set
+	RunnerManager *usecase.RunnerManager
+	// JobManager enables job actions when set
+	JobManager *usecase.JobManager
+	// Replay enables the playback controls when a recorded timeline is shown
+	Replay usecase.ReplayController
+	// PoolGrouping shows the runners grouped into pools on startup
+	PoolGrouping value_object.PoolGrouping
+	// GoneRetention is how long runners that disappeared are kept, used to describe the churn
+	GoneRetention time.Duration
+}
+
 // Model represents the TUI application state
 type Model struct {
 	table          table.Model
@@ -56,6 +88,7 @@ type Model struct {
 	org            string
 	runners        []*entity.Runner
 	jobs           []*entity.Job
+	jobIndex       *value_object.JobIndex
 	currentTime    time.Time
 	lastUpdate     time.Time
 	updateInterval time.Duration
@@ -64,18 +97,98 @@ type Model struct {
 	width          int
 	height         int
 	err            error
+	flaggedJobs    []*value_object.FlaggedJob
+	mismatches     []*value_object.StatusMismatch
+	goneRunners    []*value_object.GoneRunner
+	churn          []*value_object.LabelChurn
+	goneRetention  time.Duration
+	warnings       []error
+	eventLog       []*value_object.Event
+	notifier       *terminalNotifier
+	showEventLog   bool
+	eventViewport  viewport.Model
+	statusMessage  string
+	statusID       int
+	showHelp       bool
+
+	// Runner management state
+	runnerManager *usecase.RunnerManager
+	jobManager    *usecase.JobManager
+	mode          inputMode
+	marked        map[int64]bool
+	labelOp       labelOperation
+	textInput     textinput.Model
+	pending       *pendingAction
+
+	// Last job each runner finished, targeted by re-runs since GitHub refuses to re-run a running job
+	finishedJobs map[int64]*entity.Job
+
+	// Values of the selected job offered for copying, and where the OSC 52 sequences are written (nil without a terminal)
+	copyTargets []copyTarget
+	clipboard   io.Writer
+
+	// replay controls playback when a recorded timeline is shown
+	replay usecase.ReplayController
+
+	// View shown in place of the table, scrolled in the viewport unless it is viewTable
+	view     viewMode
+	viewport viewport.Model
+
+	// Runners grouped into pools by poolGrouping, shown in viewPools
+	poolGrouping value_object.PoolGrouping
+	pools        []*value_object.RunnerPool
+
+	// Per-label capacity summary, shown in viewCapacity
+	capacities []*value_object.LabelCapacity
+
+	// Queued jobs with their forecast wait, shown in viewQueue
+	queueForecasts []*value_object.QueueForecast
+
+	// Usage by repository and workflow, shown in viewUsage
+	usage []*value_object.RepositoryUsage
+
+	// Runner whose job and its steps are shown in viewDetail
+	detailRunnerID int64
+
+	// Log of logJob, shown in viewLog
+	// logLive is set while the job runs and the log is refreshed periodically
+	logJob     *entity.Job
+	logText    string
+	logLines   []string
+	logErr     error
+	logLive    bool
+	logSearch  string
+	logMatches []int
+	logMatch   int
+	// logGeneration changes whenever the log view is opened or closed, so that refreshes of an earlier view are dropped
+	logGeneration int
+	// logReturnView is the view the log was opened from, shown again when it is closed
+	logReturnView viewMode
+
+	// Utilization history kept across refreshes
+	historySize   int
+	showHistory   bool
+	runnerHistory map[int64]*ringBuffer[entity.RunnerStatus]
+	fleetHistory  *ringBuffer[float64]
 }
 
 // NewModel creates a new TUI model
-func NewModel(useCase *usecase.RunnerMonitor, owner, repo, org string, intervalSeconds int) *Model {
+func NewModel(useCase *usecase.RunnerMonitor, owner, repo, org string, intervalSeconds int, opts Options) *Model {
+	historySize := opts.HistorySize
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	goneRetention := opts.GoneRetention
+	if goneRetention <= 0 {
+		goneRetention = value_object.DefaultGoneRetention
+	}
+
 	// Start with minimum column widths - will be updated when WindowSizeMsg is received
-	columns := []table.Column{
-		{Title: columnTitleRunnerName, Width: minRunnerNameWidth},
-		{Title: columnTitleStatus, Width: statusWidth},
-		{Title: columnTitleLabels, Width: minLabelsWidth},
-		{Title: columnTitleJobName, Width: minJobNameWidth},
-		{Title: columnTitleExecutionTime, Width: execTimeWidth},
+	historyWidth := 0
+	if opts.ShowHistory {
+		historyWidth = getHistoryColumnWidth(historySize)
 	}
+	columns := getCalculatedColumnWidths(0, historyWidth)
 
 	s := table.DefaultStyles()
 	s.Header = s.Header.
@@ -88,13 +201,9 @@ func NewModel(useCase *usecase.RunnerMonitor, owner, repo, org string, intervalS
 		Background(lipgloss.Color("57")).
 		Bold(false)
 
-	// Calculate initial table height based on default terminal height
-	tableHeight := getCalculatedTableHeight(defaultTerminalHeight)
-
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithFocused(true),
-		table.WithHeight(tableHeight),
 		table.WithStyles(s),
 	)
 
@@ -103,7 +212,7 @@ func NewModel(useCase *usecase.RunnerMonitor, owner, repo, org string, intervalS
 	sp.Spinner = spinner.Dot
 	sp.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 
-	return &Model{
+	m := &Model{
 		table:          t,
 		spinner:        sp,
 		runnerMonitor:  useCase,
@@ -114,39 +223,94 @@ func NewModel(useCase *usecase.RunnerMonitor, owner, repo, org string, intervalS
 		loading:        true,
 		width:          defaultTerminalWidth,
 		height:         defaultTerminalHeight,
+		historySize:    historySize,
+		showHistory:    opts.ShowHistory,
+		notifier:       newTerminalNotifier(opts.Notifications),
+		eventViewport:  newEventViewport(),
+		runnerManager:  opts.RunnerManager,
+		jobManager:     opts.JobManager,
+		finishedJobs:   make(map[int64]*entity.Job),
+		replay:         opts.Replay,
+		viewport:       newViewport(),
+		clipboard:      newClipboardOutput(),
+		goneRetention:  goneRetention,
+	}
+	if opts.PoolGrouping != value_object.PoolGroupingNone {
+		m.setPoolGrouping(opts.PoolGrouping)
 	}
+
+	// Calculate initial table height based on default terminal height
+	m.updateTableHeight()
+
+	return m
 }
 
 // getCalculatedColumnWidths calculates column widths based on available terminal width
-func getCalculatedColumnWidths(terminalWidth int) []table.Column {
-	availableWidth := terminalWidth - borderPadding
+// The history column is only included when historyWidth is greater than zero
+func getCalculatedColumnWidths(terminalWidth, historyWidth int) []table.Column {
+	availableWidth := terminalWidth - borderPadding - historyWidth
 	totalMinWidth := minRunnerNameWidth + statusWidth + minLabelsWidth + minJobNameWidth + execTimeWidth
 
+	var columns []table.Column
 	if availableWidth < totalMinWidth {
 		// Terminal is too small, use minimum widths
-		return []table.Column{
+		columns = []table.Column{
 			{Title: columnTitleRunnerName, Width: minRunnerNameWidth},
 			{Title: columnTitleStatus, Width: statusWidth},
 			{Title: columnTitleLabels, Width: minLabelsWidth},
 			{Title: columnTitleJobName, Width: minJobNameWidth},
 			{Title: columnTitleExecutionTime, Width: execTimeWidth},
 		}
+	} else {
+		remainingWidth := availableWidth - totalMinWidth
+
+		// Distribute remaining width proportionally
+		runnerNameExtra := int(float64(remainingWidth) * ratioRunnerName)
+		labelsExtra := int(float64(remainingWidth) * ratioLabels)
+		jobNameExtra := int(float64(remainingWidth) * ratioJobName)
+
+		columns = []table.Column{
+			{Title: columnTitleRunnerName, Width: minRunnerNameWidth + runnerNameExtra},
+			{Title: columnTitleStatus, Width: statusWidth},
+			{Title: columnTitleLabels, Width: minLabelsWidth + labelsExtra},
+			{Title: columnTitleJobName, Width: minJobNameWidth + jobNameExtra},
+			{Title: columnTitleExecutionTime, Width: execTimeWidth},
+		}
 	}
 
-	remainingWidth := availableWidth - totalMinWidth
+	if historyWidth > 0 {
+		columns = append(columns, table.Column{Title: columnTitleHistory, Width: historyWidth})
+	}
+	return columns
+}
 
-	// Distribute remaining width proportionally
-	runnerNameExtra := int(float64(remainingWidth) * ratioRunnerName)
-	labelsExtra := int(float64(remainingWidth) * ratioLabels)
-	jobNameExtra := int(float64(remainingWidth) * ratioJobName)
+// getHistoryColumnWidth returns the width needed to show historySize refreshes
+func getHistoryColumnWidth(historySize int) int {
+	return max(historySize, minHistoryWidth)
+}
 
-	return []table.Column{
-		{Title: columnTitleRunnerName, Width: minRunnerNameWidth + runnerNameExtra},
-		{Title: columnTitleStatus, Width: statusWidth},
-		{Title: columnTitleLabels, Width: minLabelsWidth + labelsExtra},
-		{Title: columnTitleJobName, Width: minJobNameWidth + jobNameExtra},
-		{Title: columnTitleExecutionTime, Width: execTimeWidth},
+// getExtraPanelHeight returns the number of lines added around the table by optional panels
+func (m *Model) getExtraPanelHeight() int {
+	height := m.getEventLogPanelHeight() + m.getGoneRunnerPanelHeight()
+	if m.showHistory {
+		height++ // Fleet utilization sparkline
+	}
+	if len(m.flaggedJobs) > 0 {
+		height++ // Stuck job summary
+	}
+	if len(m.churn) > 0 {
+		height++ // Churn summary
 	}
+	if len(m.warnings) > 0 {
+		height++ // Latest warning
+	}
+	if m.statusMessage != "" {
+		height++ // Transient status message
+	}
+	if m.replay != nil {
+		height++ // Replay position
+	}
+	return height
 }
 
 // getCalculatedTableHeight calculates table height based on terminal height
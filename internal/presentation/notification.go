@@ -0,0 +1,129 @@
+package presentation
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Terminal notification protocols
+const (
+	OSCNone = ""
+	OSC9    = "9"
+	OSC777  = "777"
+)
+
+// notificationTitle is the title of OSC 777 notifications
+const notificationTitle = "gh-runner-monitor"
+
+// NotificationOptions configures the terminal notifications emitted for transitions
+type NotificationOptions struct {
+	// Bell rings the terminal bell
+	Bell bool
+	// OSC selects the desktop notification escape sequence (OSC9, OSC777 or OSCNone)
+	OSC string
+	// Events lists the transitions that trigger a notification
+	Events []value_object.EventType
+}
+
+// DefaultNotificationEvents are the transitions notified when none are configured
+var DefaultNotificationEvents = []value_object.EventType{
+	value_object.EventRunnerOffline,
+	value_object.EventRunnerOnline,
+}
+
+// terminalNotifier writes bell and OSC notification sequences to the terminal
+type terminalNotifier struct {
+	out    io.Writer
+	bell   bool
+	osc    string
+	tmux   bool
+	events map[value_object.EventType]bool
+}
+
+// newTerminalNotifier creates a notifier writing to stderr, which shares the terminal with the TUI
+func newTerminalNotifier(opts NotificationOptions) *terminalNotifier {
+	if !opts.Bell && opts.OSC == OSCNone {
+		return nil
+	}
+
+	events := opts.Events
+	if len(events) == 0 {
+		events = DefaultNotificationEvents
+	}
+	enabled := make(map[value_object.EventType]bool, len(events))
+	for _, e := range events {
+		enabled[e] = true
+	}
+
+	return &terminalNotifier{
+		out:    os.Stderr,
+		bell:   opts.Bell,
+		osc:    opts.OSC,
+		tmux:   os.Getenv("TMUX") != "",
+		events: enabled,
+	}
+}
+
+// notify returns a command emitting notifications for the enabled events
+func (n *terminalNotifier) notify(events []*value_object.Event) tea.Cmd {
+	if n == nil {
+		return nil
+	}
+
+	var sequences strings.Builder
+	notified := 0
+	for _, event := range events {
+		if !n.events[event.Type] {
+			continue
+		}
+		notified++
+		if n.osc != OSCNone {
+			sequences.WriteString(n.wrap(formatOSCNotification(n.osc, event.Message)))
+		}
+	}
+	if notified == 0 {
+		return nil
+	}
+	if n.bell {
+		// A single bell is enough even when several transitions happened at once
+		sequences.WriteString("\a")
+	}
+
+	return func() tea.Msg {
+		_, _ = io.WriteString(n.out, sequences.String())
+		return nil
+	}
+}
+
+// wrap encloses an escape sequence in a tmux passthrough sequence when running inside tmux
+func (n *terminalNotifier) wrap(sequence string) string {
+	if !n.tmux {
+		return sequence
+	}
+	return "\x1bPtmux;" + strings.ReplaceAll(sequence, "\x1b", "\x1b\x1b") + "\x1b\\"
+}
+
+// formatOSCNotification formats a desktop notification escape sequence
+func formatOSCNotification(osc, message string) string {
+	// Control characters would terminate the sequence early
+	message = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return ' '
+		}
+		return r
+	}, message)
+
+	switch osc {
+	case OSC9:
+		return fmt.Sprintf("\x1b]9;%s\a", message)
+	case OSC777:
+		return fmt.Sprintf("\x1b]777;notify;%s;%s\a", notificationTitle, message)
+	default:
+		return ""
+	}
+}
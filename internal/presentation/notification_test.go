@@ -0,0 +1,92 @@
+package presentation
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestFormatOSCNotification(t *testing.T) {
+	tests := []struct {
+		name     string
+		osc      string
+		message  string
+		expected string
+	}{
+		{
+			name:     "OSC 9",
+			osc:      OSC9,
+			message:  "Runner went offline",
+			expected: "\x1b]9;Runner went offline\a",
+		},
+		{
+			name:     "OSC 777",
+			osc:      OSC777,
+			message:  "Runner went offline",
+			expected: "\x1b]777;notify;gh-runner-monitor;Runner went offline\a",
+		},
+		{
+			name:     "control characters are replaced",
+			osc:      OSC9,
+			message:  "a\x07b",
+			expected: "\x1b]9;a b\a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := formatOSCNotification(tt.osc, tt.message)
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTerminalNotifier(t *testing.T) {
+	events := []*value_object.Event{
+		{Type: value_object.EventJobStarted, Message: "started"},
+		{Type: value_object.EventRunnerOffline, Message: "offline"},
+	}
+
+	t.Run("disabled when neither bell nor OSC is set", func(t *testing.T) {
+		if n := newTerminalNotifier(NotificationOptions{}); n != nil {
+			t.Error("expected nil notifier")
+		}
+	})
+
+	t.Run("notifies only enabled events", func(t *testing.T) {
+		var out bytes.Buffer
+		n := &terminalNotifier{
+			out:    &out,
+			bell:   true,
+			osc:    OSC9,
+			events: map[value_object.EventType]bool{value_object.EventRunnerOffline: true},
+		}
+
+		cmd := n.notify(events)
+		if cmd == nil {
+			t.Fatal("expected a command")
+		}
+		cmd()
+
+		if out.String() != "\x1b]9;offline\a\a" {
+			t.Errorf("unexpected output %q", out.String())
+		}
+	})
+
+	t.Run("wraps sequences for tmux", func(t *testing.T) {
+		n := &terminalNotifier{tmux: true}
+		if result := n.wrap("\x1b]9;x\a"); result != "\x1bPtmux;\x1b\x1b]9;x\a\x1b\\" {
+			t.Errorf("unexpected passthrough sequence %q", result)
+		}
+	})
+
+	t.Run("no command without matching events", func(t *testing.T) {
+		n := &terminalNotifier{bell: true, events: map[value_object.EventType]bool{}}
+		if cmd := n.notify(events); cmd != nil {
+			t.Error("expected no command")
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package presentation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// cyclePoolGrouping switches from the runner table through the pool groupings and back
+func (m *Model) cyclePoolGrouping() {
+	current := value_object.PoolGroupingNone
+	if m.view == viewPools {
+		current = m.poolGrouping
+	}
+	next := value_object.PoolGroupingNone
+	if i := slices.Index(value_object.PoolGroupings, current); i+1 < len(value_object.PoolGroupings) {
+		next = value_object.PoolGroupings[i+1]
+	}
+	m.setPoolGrouping(next)
+}
+
+// setPoolGrouping shows the runners grouped into pools, or the runner table for PoolGroupingNone
+func (m *Model) setPoolGrouping(grouping value_object.PoolGrouping) {
+	if grouping == value_object.PoolGroupingNone {
+		m.setView(viewTable)
+		return
+	}
+	m.poolGrouping = grouping
+	m.setView(viewPools)
+}
+
+// updatePoolKeys handles the keys of the pool view and returns true if the key was handled
+func (m *Model) updatePoolKeys(key string) bool {
+	if key != "P" {
+		return false
+	}
+	m.cyclePoolGrouping()
+	return true
+}
+
+// poolView renders the pools in place of the runner table
+func (m *Model) poolView() string {
+	return m.viewportView(fmt.Sprintf("Pools by %s (%d pools) | 'P' to switch grouping, 'esc' to close, ↑/↓ to scroll",
+		formatPoolGrouping(m.poolGrouping), len(m.pools)))
+}
+
+// poolContent renders every pool with its counts followed by its running and queued jobs
+func (m *Model) poolContent() string {
+	if len(m.pools) == 0 {
+		return "No runners"
+	}
+
+	var lines []string
+	for _, pool := range m.pools {
+		lines = append(lines, fmt.Sprintf("%s  desired %d | current %d | busy %d | idle %d | offline %d | queued %d",
+			pool.Name, pool.Desired(), pool.Current, pool.Busy, pool.Idle(), pool.Offline, len(pool.QueuedJobs)))
+		for _, job := range pool.RunningJobs {
+			line := fmt.Sprintf("    %s %s (%s) %s", getStatusIcon(entity.StatusActive), job.Name, job.WorkflowName,
+				formatDuration(job.GetExecutionDurationAt(m.currentTime)))
+			if job.HasRunnerName() {
+				line += " on " + *job.RunnerName
+			}
+			lines = append(lines, line)
+		}
+		for _, job := range pool.QueuedJobs {
+			lines = append(lines, fmt.Sprintf("    %s %s (%s) queued %s", getFlagIcon(value_object.FlagLongQueued),
+				job.Name, job.WorkflowName, formatDuration(job.GetQueuedDurationAt(m.currentTime))))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatPoolGrouping describes a pool grouping in the pool view title
+func formatPoolGrouping(grouping value_object.PoolGrouping) string {
+	switch grouping {
+	case value_object.PoolGroupingPrefix:
+		return "scale set / name prefix"
+	case value_object.PoolGroupingLabels:
+		return "label set"
+	default:
+		return string(grouping)
+	}
+}
@@ -0,0 +1,71 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestPoolView(t *testing.T) {
+	data := &value_object.MonitorData{
+		Runners: []*entity.Runner{
+			{ID: 1, Name: "arc-linux-abcde-runner-11111", Status: entity.StatusIdle, Labels: []string{"arc-linux"}},
+			{ID: 2, Name: "arc-linux-abcde-runner-22222", Status: entity.StatusIdle, Labels: []string{"arc-linux"}},
+		},
+	}
+	pressP := key("P")
+
+	t.Run("cycles through the groupings back to the table", func(t *testing.T) {
+		m := newTestModel(Options{}, nil, nil)
+
+		expected := []value_object.PoolGrouping{
+			value_object.PoolGroupingPrefix,
+			value_object.PoolGroupingLabels,
+			value_object.PoolGroupingNone,
+		}
+		for _, grouping := range expected {
+			m.Update(pressP)
+			if m.poolGrouping != grouping {
+				t.Fatalf("expected grouping %q, got %q", grouping, m.poolGrouping)
+			}
+		}
+		if m.view != viewTable || !m.table.Focused() {
+			t.Error("expected the table to be focused again")
+		}
+	})
+
+	t.Run("regroups new snapshots", func(t *testing.T) {
+		m := newTestModel(Options{}, nil, nil)
+		m.Update(pressP)
+
+		m.Update(value_object.DataMsg{Data: data})
+
+		if len(m.pools) != 1 || m.pools[0].Name != "arc-linux" {
+			t.Fatalf("expected a single arc-linux pool, got %v", m.pools)
+		}
+		if view := m.View(); !strings.Contains(view, "arc-linux  desired 0 | current 2") {
+			t.Errorf("expected the pool in the view, got %q", view)
+		}
+	})
+
+	t.Run("starts grouped when configured", func(t *testing.T) {
+		m := NewModel(nil, "owner", "repo", "", 5, Options{PoolGrouping: value_object.PoolGroupingLabels})
+
+		if m.view != viewPools || m.poolGrouping != value_object.PoolGroupingLabels {
+			t.Errorf("expected the pools by labels, got view %d grouped by %q", m.view, m.poolGrouping)
+		}
+	})
+
+	t.Run("esc returns to the table", func(t *testing.T) {
+		m := newTestModel(Options{}, nil, nil)
+		m.Update(pressP)
+
+		m.Update(key("esc"))
+
+		if m.view != viewTable || m.poolGrouping != value_object.PoolGroupingNone {
+			t.Errorf("expected the runner table, got view %d grouped by %q", m.view, m.poolGrouping)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// queueView renders the queued jobs in place of the runner table
+func (m *Model) queueView() string {
+	return m.viewportView(fmt.Sprintf("Queued Jobs (%d, longest waiting first) | 'Q'/'esc' to close, ↑/↓ to scroll",
+		len(m.queueForecasts)))
+}
+
+// queueContent renders one aligned line per queued job below a header line
+func (m *Model) queueContent() string {
+	if len(m.queueForecasts) == 0 {
+		return "No queued jobs"
+	}
+
+	jobWidth := len(columnTitleJobName)
+	labelsWidth := len(columnTitleLabels)
+	for _, forecast := range m.queueForecasts {
+		jobWidth = max(jobWidth, len([]rune(formatJobName(forecast))))
+		labelsWidth = max(labelsWidth, len([]rune(formatLabels(forecast.Job.Labels))))
+	}
+
+	lines := make([]string, 0, len(m.queueForecasts)+1)
+	lines = append(lines, fmt.Sprintf("%-*s  %-*s  %8s  %8s  %9s  %s",
+		jobWidth, columnTitleJobName, labelsWidth, columnTitleLabels, "Waiting", "Position", "Starts in", "Confidence"))
+	for _, forecast := range m.queueForecasts {
+		lines = append(lines, fmt.Sprintf("%-*s  %-*s  %8s  %8d  %9s  %s",
+			jobWidth, formatJobName(forecast), labelsWidth, formatLabels(forecast.Job.Labels),
+			formatDuration(forecast.Job.GetQueuedDurationAt(m.currentTime)), forecast.Position+1,
+			formatEstimatedWait(forecast), formatConfidence(forecast)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatJobName formats the name and workflow of a forecast job
+func formatJobName(forecast *value_object.QueueForecast) string {
+	return fmt.Sprintf("%s (%s)", forecast.Job.Name, forecast.Job.WorkflowName)
+}
+
+// formatEstimatedWait formats the forecast wait, "now" when a runner is idle and "?" without history
+func formatEstimatedWait(forecast *value_object.QueueForecast) string {
+	switch {
+	case forecast.Confidence == value_object.ConfidenceNone:
+		return "?"
+	case forecast.EstimatedWait == 0:
+		return "now"
+	default:
+		return "~" + formatDuration(forecast.EstimatedWait)
+	}
+}
+
+// formatConfidence renders the confidence as a three dot indicator followed by the number of samples
+func formatConfidence(forecast *value_object.QueueForecast) string {
+	var dots string
+	switch forecast.Confidence {
+	case value_object.ConfidenceHigh:
+		dots = "●●●"
+	case value_object.ConfidenceMedium:
+		dots = "●●○"
+	case value_object.ConfidenceLow:
+		dots = "●○○"
+	default:
+		return "○○○ no history"
+	}
+	return fmt.Sprintf("%s %s (%d jobs)", dots, forecast.Confidence, forecast.Samples)
+}
@@ -0,0 +1,73 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestFormatEstimatedWait(t *testing.T) {
+	tests := []struct {
+		name     string
+		forecast *value_object.QueueForecast
+		expected string
+	}{
+		{
+			name:     "no history",
+			forecast: &value_object.QueueForecast{Confidence: value_object.ConfidenceNone},
+			expected: "?",
+		},
+		{
+			name:     "idle runner",
+			forecast: &value_object.QueueForecast{Confidence: value_object.ConfidenceLow},
+			expected: "now",
+		},
+		{
+			name:     "estimated wait",
+			forecast: &value_object.QueueForecast{Confidence: value_object.ConfidenceHigh, EstimatedWait: 90 * time.Second},
+			expected: "~01:30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := formatEstimatedWait(tt.forecast); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestQueueView(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-2 * time.Minute)
+	job := &entity.Job{ID: 100, Name: "train", WorkflowName: "ML", Status: "queued", Labels: []string{"gpu"}, CreatedAt: &createdAt}
+	data := &value_object.MonitorData{
+		CurrentTime: now,
+		Jobs:        []*entity.Job{job},
+		QueueForecasts: []*value_object.QueueForecast{
+			{Job: job, EstimatedWait: 5 * time.Minute, Confidence: value_object.ConfidenceMedium, Samples: 4},
+		},
+	}
+	pressQ := key("Q")
+
+	m := NewModel(nil, "owner", "repo", "", 5, Options{})
+	m.Update(value_object.DataMsg{Data: data})
+
+	m.Update(pressQ)
+	view := m.View()
+	if !strings.Contains(view, "Queued Jobs (1, longest waiting first)") {
+		t.Errorf("expected the queued jobs title, got %q", view)
+	}
+	if !strings.Contains(view, "train (ML)") || !strings.Contains(view, "~05:00") || !strings.Contains(view, "●●○ Medium (4 jobs)") {
+		t.Errorf("expected the forecast of train, got %q", view)
+	}
+
+	m.Update(pressQ)
+	if m.view != viewTable || !m.table.Focused() {
+		t.Error("expected the runner table to be shown again")
+	}
+}
@@ -0,0 +1,103 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clock layouts accepted by the jump prompt, applied to the date of the current snapshot
+var jumpClockLayouts = []string{"15:04:05", "15:04"}
+
+// Absolute layouts accepted by the jump prompt
+var jumpDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04"}
+
+// toggleReplayPause pauses or resumes playback of the recorded timeline
+func (m *Model) toggleReplayPause() tea.Cmd {
+	if m.replay == nil {
+		return nil
+	}
+	if m.replay.TogglePause() {
+		return m.setStatusMessage("Replay paused")
+	}
+	return m.setStatusMessage("Replay resumed")
+}
+
+// stepReplay moves delta snapshots through the recorded timeline and refreshes
+func (m *Model) stepReplay(delta int) tea.Cmd {
+	if m.replay == nil {
+		return nil
+	}
+	m.replay.Step(delta)
+	return m.fetchData()
+}
+
+// startJumpInput opens the prompt for the time to jump to
+func (m *Model) startJumpInput() tea.Cmd {
+	if m.replay == nil {
+		return nil
+	}
+
+	m.textInput = textinput.New()
+	m.textInput.Placeholder = "15:04, 2006-01-02 15:04:05 or -5m"
+	m.textInput.Prompt = "Jump to: "
+	m.mode = modeJumpInput
+	m.table.Blur()
+	return m.textInput.Focus()
+}
+
+// submitJumpInput jumps to the entered time and refreshes
+func (m *Model) submitJumpInput() tea.Cmd {
+	value := m.textInput.Value()
+	m.cancelInput()
+
+	t, err := parseJumpTime(value, m.replay.Position().Time)
+	if err != nil {
+		return m.setStatusMessage(fmt.Sprintf("Error: %s", err))
+	}
+	m.replay.JumpTo(t)
+	return m.fetchData()
+}
+
+// parseJumpTime parses an absolute time, a clock time or an offset relative to current
+func parseJumpTime(s string, current time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid offset %q", s)
+		}
+		return current.Add(d), nil
+	}
+
+	for _, layout := range jumpDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, current.Location()); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range jumpClockLayouts {
+		if t, err := time.ParseInLocation(layout, s, current.Location()); err == nil {
+			year, month, day := current.Date()
+			return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), 0, current.Location()), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// formatReplayPosition renders the playback position shown in the header
+func formatReplayPosition(pos value_object.ReplayPosition) string {
+	state := "▶ playing"
+	if pos.Paused {
+		state = "⏸ paused"
+	}
+	speed := "per refresh"
+	if pos.Speed > 0 {
+		speed = fmt.Sprintf("%gx", pos.Speed)
+	}
+	return fmt.Sprintf("Replay %s | snapshot %d/%d at %s (%s) | 'p' pause, '['/']' step, 't' jump",
+		state, pos.Index+1, pos.Count, pos.Time.Format("2006-01-02 15:04:05"), speed)
+}
@@ -0,0 +1,108 @@
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+func TestParseJumpTime(t *testing.T) {
+	current := time.Date(2025, 11, 3, 10, 35, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{name: "clock time", input: "09:15", expected: time.Date(2025, 11, 3, 9, 15, 0, 0, time.UTC)},
+		{name: "clock time with seconds", input: "09:15:30", expected: time.Date(2025, 11, 3, 9, 15, 30, 0, time.UTC)},
+		{name: "date and time", input: "2025-11-02 23:00", expected: time.Date(2025, 11, 2, 23, 0, 0, 0, time.UTC)},
+		{name: "RFC3339", input: "2025-11-03T08:00:00Z", expected: time.Date(2025, 11, 3, 8, 0, 0, 0, time.UTC)},
+		{name: "backward offset", input: "-5m", expected: time.Date(2025, 11, 3, 10, 30, 0, 0, time.UTC)},
+		{name: "forward offset", input: "+1h", expected: time.Date(2025, 11, 3, 11, 35, 0, 0, time.UTC)},
+		{name: "invalid", input: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseJumpTime(tt.input, current)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestReplayControls(t *testing.T) {
+	newModel := func() (*Model, *test.StubReplayController) {
+		replay := &test.StubReplayController{
+			Current: value_object.ReplayPosition{Index: 2, Count: 10, Time: time.Date(2025, 11, 3, 10, 35, 0, 0, time.UTC)},
+		}
+		return newTestModel(Options{Replay: replay}, nil, nil), replay
+	}
+
+	t.Run("pause toggles playback", func(t *testing.T) {
+		m, replay := newModel()
+
+		m.Update(key("p"))
+
+		if !replay.Current.Paused {
+			t.Error("expected playback to be paused")
+		}
+		if m.statusMessage != "Replay paused" {
+			t.Errorf("unexpected status message %q", m.statusMessage)
+		}
+	})
+
+	t.Run("brackets step through snapshots", func(t *testing.T) {
+		m, replay := newModel()
+
+		m.Update(key("]"))
+		m.Update(key("["))
+
+		if len(replay.Steps) != 2 || replay.Steps[0] != 1 || replay.Steps[1] != -1 {
+			t.Errorf("expected steps [1 -1], got %v", replay.Steps)
+		}
+	})
+
+	t.Run("jump prompt jumps to the entered time", func(t *testing.T) {
+		m, replay := newModel()
+
+		m.Update(key("t"))
+		if m.mode != modeJumpInput {
+			t.Fatal("expected jump prompt")
+		}
+		m.Update(key("10:00"))
+		m.Update(key("enter"))
+
+		if m.mode != modeNormal {
+			t.Error("expected the prompt to close")
+		}
+		expected := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+		if len(replay.Jumps) != 1 || !replay.Jumps[0].Equal(expected) {
+			t.Errorf("expected jump to %v, got %v", expected, replay.Jumps)
+		}
+	})
+
+	t.Run("controls are ignored without a recording", func(t *testing.T) {
+		m := NewModel(nil, "owner", "repo", "", 5, Options{})
+
+		m.Update(key("t"))
+
+		if m.mode != modeNormal {
+			t.Error("expected no prompt without a recording")
+		}
+	})
+}
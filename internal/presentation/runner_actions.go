@@ -0,0 +1,315 @@
+package presentation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// inputMode determines how key presses are interpreted
+type inputMode int
+
+const (
+	modeNormal inputMode = iota
+	modeLabelInput
+	modeConfirm
+	modeJumpInput
+	modeLogSearchInput
+	modeCopyChoice
+)
+
+// labelOperation identifies the label change requested from the label prompt
+type labelOperation string
+
+const (
+	labelAdd    labelOperation = "+"
+	labelRemove labelOperation = "-"
+	labelSet    labelOperation = "="
+)
+
+// pendingAction is an action waiting for user confirmation
+type pendingAction struct {
+	prompt string
+	run    tea.Cmd
+}
+
+// actionResultMsg reports the result of a write action
+type actionResultMsg struct {
+	message string
+	err     error
+}
+
+// selectedRunner returns the runner on the selected row, or nil if there is none
+func (m *Model) selectedRunner() *entity.Runner {
+	selectedRow := m.table.Cursor()
+	if selectedRow < 0 || selectedRow >= len(m.runners) {
+		return nil
+	}
+	return m.runners[selectedRow]
+}
+
+// targetRunners returns the marked runners, or the selected runner when none are marked
+func (m *Model) targetRunners() []*entity.Runner {
+	var targets []*entity.Runner
+	for _, runner := range m.runners {
+		if m.marked[runner.ID] {
+			targets = append(targets, runner)
+		}
+	}
+	if len(targets) > 0 {
+		return targets
+	}
+	if runner := m.selectedRunner(); runner != nil {
+		return []*entity.Runner{runner}
+	}
+	return nil
+}
+
+// toggleMark marks or unmarks the selected runner for bulk actions
+func (m *Model) toggleMark() {
+	runner := m.selectedRunner()
+	if runner == nil {
+		return
+	}
+	if m.marked == nil {
+		m.marked = make(map[int64]bool)
+	}
+	if m.marked[runner.ID] {
+		delete(m.marked, runner.ID)
+	} else {
+		m.marked[runner.ID] = true
+	}
+	m.updateTableRows()
+	m.table.MoveDown(1)
+}
+
+// markOfflineRunners marks every offline runner for bulk actions
+func (m *Model) markOfflineRunners() tea.Cmd {
+	m.marked = make(map[int64]bool)
+	for _, runner := range m.runners {
+		if runner.Status == entity.StatusOffline {
+			m.marked[runner.ID] = true
+		}
+	}
+	m.updateTableRows()
+	return m.setStatusMessage(fmt.Sprintf("Marked %d offline runner(s)", len(m.marked)))
+}
+
+// pruneMarks forgets marks of runners that are no longer registered
+func (m *Model) pruneMarks() {
+	if len(m.marked) == 0 {
+		return
+	}
+	present := make(map[int64]bool, len(m.runners))
+	for _, runner := range m.runners {
+		present[runner.ID] = true
+	}
+	for id := range m.marked {
+		if !present[id] {
+			delete(m.marked, id)
+		}
+	}
+}
+
+// checkRunnerManagement returns a status command explaining why runner management is unavailable, or nil
+func (m *Model) checkRunnerManagement() tea.Cmd {
+	if m.runnerManager == nil {
+		return m.setStatusMessage("Runner management is not available")
+	}
+	if m.runnerManager.IsReadOnly() {
+		return m.setStatusMessage("Read-only mode: runner management is disabled")
+	}
+	return nil
+}
+
+// confirmDeleteRunners asks for confirmation before deleting the target runners
+func (m *Model) confirmDeleteRunners() tea.Cmd {
+	if cmd := m.checkRunnerManagement(); cmd != nil {
+		return cmd
+	}
+	runners := m.targetRunners()
+	if len(runners) == 0 {
+		return nil
+	}
+
+	m.confirm(fmt.Sprintf("Delete %d runner(s): %s?", len(runners), runnerNames(runners)), func() tea.Msg {
+		err := m.runnerManager.DeleteRunners(context.Background(), m.owner, m.repo, m.org, runners)
+		return actionResultMsg{message: fmt.Sprintf("Deleted %d runner(s)", len(runners)), err: err}
+	})
+	return nil
+}
+
+// startLabelInput opens the label prompt for the given operation
+func (m *Model) startLabelInput(op labelOperation) tea.Cmd {
+	if cmd := m.checkRunnerManagement(); cmd != nil {
+		return cmd
+	}
+	if len(m.targetRunners()) == 0 {
+		return nil
+	}
+
+	m.labelOp = op
+	m.textInput = textinput.New()
+	m.textInput.Placeholder = "label1, label2"
+	m.textInput.Prompt = fmt.Sprintf("%s labels: ", labelOperationName(op))
+	m.mode = modeLabelInput
+	m.table.Blur()
+	return m.textInput.Focus()
+}
+
+// submitLabelInput turns the entered labels into a pending action
+func (m *Model) submitLabelInput() {
+	labels := parseLabels(m.textInput.Value())
+	runners := m.targetRunners()
+	if len(runners) == 0 || (len(labels) == 0 && m.labelOp != labelSet) {
+		m.cancelInput()
+		return
+	}
+
+	op := m.labelOp
+	prompt := fmt.Sprintf("%s labels [%s] on %d runner(s): %s?",
+		labelOperationName(op), strings.Join(labels, ", "), len(runners), runnerNames(runners))
+	m.confirm(prompt, func() tea.Msg {
+		ctx := context.Background()
+		var err error
+		switch op {
+		case labelAdd:
+			err = m.runnerManager.AddLabels(ctx, m.owner, m.repo, m.org, runners, labels)
+		case labelRemove:
+			err = m.runnerManager.RemoveLabels(ctx, m.owner, m.repo, m.org, runners, labels)
+		case labelSet:
+			err = m.runnerManager.SetLabels(ctx, m.owner, m.repo, m.org, runners, labels)
+		}
+		return actionResultMsg{message: fmt.Sprintf("Updated labels of %d runner(s)", len(runners)), err: err}
+	})
+}
+
+// confirm shows a confirmation dialog that runs the action when accepted
+func (m *Model) confirm(prompt string, run tea.Cmd) {
+	m.pending = &pendingAction{prompt: prompt, run: run}
+	m.mode = modeConfirm
+	m.table.Blur()
+}
+
+// cancelInput leaves the prompt or confirmation dialog without running anything
+func (m *Model) cancelInput() {
+	m.pending = nil
+	m.mode = modeNormal
+	m.table.Focus()
+}
+
+// updateInput handles key presses while a prompt or confirmation dialog is shown
+func (m *Model) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch m.mode {
+	case modeLabelInput:
+		switch msg.String() {
+		case "esc":
+			m.cancelInput()
+			return m, nil
+		case "enter":
+			m.submitLabelInput()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.textInput, cmd = m.textInput.Update(msg)
+		return m, cmd
+
+	case modeJumpInput:
+		switch msg.String() {
+		case "esc":
+			m.cancelInput()
+			return m, nil
+		case "enter":
+			return m, m.submitJumpInput()
+		}
+		var cmd tea.Cmd
+		m.textInput, cmd = m.textInput.Update(msg)
+		return m, cmd
+
+	case modeCopyChoice:
+		return m.updateCopyChoice(msg)
+
+	case modeLogSearchInput:
+		switch msg.String() {
+		case "esc":
+			m.cancelLogSearchInput()
+			return m, nil
+		case "enter":
+			return m, m.submitLogSearchInput()
+		}
+		var cmd tea.Cmd
+		m.textInput, cmd = m.textInput.Update(msg)
+		return m, cmd
+
+	case modeConfirm:
+		switch msg.String() {
+		case "y", "Y":
+			run := m.pending.run
+			m.cancelInput()
+			m.marked = nil
+			m.updateTableRows()
+			return m, run
+		case "n", "N", "esc":
+			m.cancelInput()
+			return m, nil
+		}
+	}
+	return m, nil
+}
+
+// dialogView renders the label prompt or confirmation dialog
+func (m *Model) dialogView() string {
+	switch m.mode {
+	case modeLabelInput, modeJumpInput, modeLogSearchInput:
+		return dialogStyle.Render(m.textInput.View() + "\n\n'enter' to continue, 'esc' to cancel")
+	case modeConfirm:
+		return dialogStyle.Render(m.pending.prompt + "\n\n'y' to confirm, 'n' to cancel")
+	case modeCopyChoice:
+		return dialogStyle.Render(m.copyChoiceView() + "\n\nany other key to cancel")
+	default:
+		return ""
+	}
+}
+
+// labelOperationName returns the verb describing a label operation
+func labelOperationName(op labelOperation) string {
+	switch op {
+	case labelAdd:
+		return "Add"
+	case labelRemove:
+		return "Remove"
+	case labelSet:
+		return "Set"
+	default:
+		return string(op)
+	}
+}
+
+// parseLabels splits a comma-separated list of labels, ignoring blanks
+func parseLabels(s string) []string {
+	var labels []string
+	for _, label := range strings.Split(s, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// runnerNames formats runner names for prompts, abbreviating long lists
+func runnerNames(runners []*entity.Runner) string {
+	const maxNames = 5
+	names := make([]string, 0, min(len(runners), maxNames))
+	for i, runner := range runners {
+		if i == maxNames {
+			names = append(names, fmt.Sprintf("and %d more", len(runners)-maxNames))
+			break
+		}
+		names = append(names, runner.Name)
+	}
+	return strings.Join(names, ", ")
+}
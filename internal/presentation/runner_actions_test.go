@@ -0,0 +1,173 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "empty", input: "", expected: nil},
+		{name: "single", input: "gpu", expected: []string{"gpu"}},
+		{name: "trims blanks", input: " gpu, ,linux ", expected: []string{"gpu", "linux"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseLabels(tt.input)
+			if len(result) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, result)
+			}
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerActions(t *testing.T) {
+	newModel := func(runnerRepo *test.StubRunnerRepository, readOnly bool) *Model {
+		return newTestModel(Options{RunnerManager: usecase.NewRunnerManager(runnerRepo, readOnly)},
+			[]*entity.Runner{
+				{ID: 1, Name: "runner-1", Status: entity.StatusIdle},
+				{ID: 2, Name: "runner-2", Status: entity.StatusOffline},
+				{ID: 3, Name: "runner-3", Status: entity.StatusOffline},
+			}, nil)
+	}
+
+	t.Run("targets the selected runner when nothing is marked", func(t *testing.T) {
+		m := newModel(&test.StubRunnerRepository{}, false)
+
+		targets := m.targetRunners()
+		if len(targets) != 1 || targets[0].ID != 1 {
+			t.Errorf("expected selected runner, got %v", targets)
+		}
+	})
+
+	t.Run("deletes all offline runners after confirmation", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		m := newModel(runnerRepo, false)
+
+		m.markOfflineRunners()
+		m.Update(key("D"))
+		if m.mode != modeConfirm {
+			t.Fatal("expected confirmation dialog")
+		}
+
+		_, cmd := m.Update(key("y"))
+		if cmd == nil {
+			t.Fatal("expected delete command")
+		}
+		msg, ok := cmd().(actionResultMsg)
+		if !ok || msg.err != nil {
+			t.Fatalf("unexpected result %+v", msg)
+		}
+
+		if len(runnerRepo.DeletedRunnerIDs) != 2 || runnerRepo.DeletedRunnerIDs[0] != 2 || runnerRepo.DeletedRunnerIDs[1] != 3 {
+			t.Errorf("expected offline runners to be deleted, got %v", runnerRepo.DeletedRunnerIDs)
+		}
+		if len(m.marked) != 0 {
+			t.Error("expected marks to be cleared")
+		}
+	})
+
+	t.Run("cancelling the confirmation does nothing", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		m := newModel(runnerRepo, false)
+
+		m.Update(key("D"))
+		m.Update(key("n"))
+
+		if m.mode != modeNormal {
+			t.Error("expected normal mode after cancelling")
+		}
+		if len(runnerRepo.DeletedRunnerIDs) != 0 {
+			t.Error("expected no runners to be deleted")
+		}
+	})
+
+	t.Run("read-only mode does not open the dialog", func(t *testing.T) {
+		m := newModel(&test.StubRunnerRepository{}, true)
+
+		m.Update(key("D"))
+
+		if m.mode != modeNormal {
+			t.Error("expected no dialog in read-only mode")
+		}
+		if m.statusMessage == "" {
+			t.Error("expected a read-only status message")
+		}
+	})
+
+	t.Run("label prompt leads to confirmation", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		m := newModel(runnerRepo, false)
+
+		m.Update(key("+"))
+		if m.mode != modeLabelInput {
+			t.Fatal("expected label prompt")
+		}
+		m.Update(key("gpu"))
+		m.Update(key("enter"))
+		if m.mode != modeConfirm {
+			t.Fatal("expected confirmation dialog")
+		}
+
+		_, cmd := m.Update(key("y"))
+		cmd()
+
+		if len(runnerRepo.LabelCalls) != 1 || runnerRepo.LabelCalls[0].Labels[0] != "gpu" {
+			t.Errorf("expected gpu label to be added, got %+v", runnerRepo.LabelCalls)
+		}
+	})
+}
+
+func TestPromptsTakeQ(t *testing.T) {
+	runnerID := int64(1)
+	pressQ := key("q")
+
+	tests := []struct {
+		name  string
+		start func(m *Model)
+		mode  inputMode
+	}{
+		{name: "label prompt", start: func(m *Model) { m.startLabelInput(labelAdd) }, mode: modeLabelInput},
+		{name: "replay jump prompt", start: func(m *Model) { m.startJumpInput() }, mode: modeJumpInput},
+		{name: "log search prompt", start: func(m *Model) { m.startLogSearchInput() }, mode: modeLogSearchInput},
+		{name: "copy choice dialog", start: func(m *Model) { m.startCopyChoice() }, mode: modeCopyChoice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel(nil, "owner", "repo", "", 5, Options{
+				RunnerManager: usecase.NewRunnerManager(&test.StubRunnerRepository{}, false),
+				Replay:        &test.StubReplayController{},
+			})
+			m.runners = []*entity.Runner{{ID: runnerID, Name: "runner-1", Status: entity.StatusActive}}
+			m.jobs = []*entity.Job{{ID: 10, RunID: 100, Name: "build", Status: "in_progress", RunnerID: &runnerID}}
+			m.updateTableRows()
+
+			tt.start(m)
+			if m.mode != tt.mode {
+				t.Fatalf("expected mode %d, got %d", tt.mode, m.mode)
+			}
+			m.Update(pressQ)
+
+			if m.quitting {
+				t.Error("expected q not to quit from a prompt")
+			}
+			if tt.mode != modeCopyChoice && m.textInput.Value() != "q" {
+				t.Errorf("expected q to be typed into the prompt, got %q", m.textInput.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package presentation
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// statusMessageDuration is how long a transient status message stays visible
+const statusMessageDuration = 3 * time.Second
+
+// clearStatusMsg clears the status message with the matching id
+type clearStatusMsg struct {
+	id int
+}
+
+// setStatusMessage shows a transient message in the header and schedules its removal
+func (m *Model) setStatusMessage(message string) tea.Cmd {
+	m.statusID++
+	m.statusMessage = message
+	m.updateTableHeight()
+
+	id := m.statusID
+	return tea.Tick(statusMessageDuration, func(time.Time) tea.Msg {
+		return clearStatusMsg{id: id}
+	})
+}
+
+// clearStatusMessage removes the status message unless a newer one replaced it
+func (m *Model) clearStatusMessage(id int) {
+	if id != m.statusID {
+		return
+	}
+	m.statusMessage = ""
+	m.updateTableHeight()
+}
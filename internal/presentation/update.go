@@ -7,7 +7,9 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/usecase"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
@@ -19,20 +21,105 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		m.table.SetHeight(getCalculatedTableHeight(msg.Height))
+		m.eventViewport.Width = msg.Width
+		m.viewport.Width = msg.Width
+		m.updateTableHeight()
 		m.updateColumnWidths()
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "ctrl+c":
+		if msg.String() == "ctrl+c" {
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+		// Prompts and dialogs take every other key, so that "q" can be typed
+		if m.mode != modeNormal {
+			return m.updateInput(msg)
+		}
+
+		if msg.String() == "q" {
 			m.quitting = true
 			return m, tea.Quit
+		}
+
+		if m.showHelp {
+			// Any key closes the help dialog
+			m.showHelp = false
+			return m, nil
+		}
+
+		if m.showEventLog {
+			return m.updateEventLog(msg)
+		}
+
+		if m.view != viewTable {
+			return m.updateViewKeys(msg)
+		}
+
+		switch msg.String() {
+		case "?":
+			m.showHelp = true
+			return m, nil
+		case "e":
+			m.toggleEventLog()
+			return m, nil
+		case "x":
+			return m, m.exportEventLog()
+		case "m":
+			m.toggleMark()
+			return m, nil
+		case "o":
+			return m, m.markOfflineRunners()
+		case "D":
+			return m, m.confirmDeleteRunners()
+		case "+", "-", "=":
+			return m, m.startLabelInput(labelOperation(msg.String()))
+		case "c":
+			return m, m.confirmJobAction(usecase.JobActionCancel)
+		case "C":
+			return m, m.confirmJobAction(usecase.JobActionForceCancel)
+		case "F":
+			return m, m.confirmJobAction(usecase.JobActionRerunFailedJobs)
+		case "R":
+			return m, m.confirmJobAction(usecase.JobActionRerunJob)
+		case "p":
+			return m, m.toggleReplayPause()
+		case "]":
+			return m, m.stepReplay(1)
+		case "[":
+			return m, m.stepReplay(-1)
+		case "t":
+			return m, m.startJumpInput()
 		case "r":
 			m.loading = true
 			return m, tea.Batch(m.spinner.Tick, m.fetchData())
 		case "enter", "return":
 			return m, m.openJobLog()
+		case "P":
+			m.cyclePoolGrouping()
+			return m, nil
+		case "L":
+			m.toggleView(viewCapacity)
+			return m, nil
+		case "Q":
+			m.toggleView(viewQueue)
+			return m, nil
+		case "w":
+			m.toggleView(viewUsage)
+			return m, nil
+		case "v":
+			return m, m.openJobDetail()
+		case "l":
+			return m, m.openJobLogView(m.selectedJob())
+		case "y":
+			return m, m.startCopyChoice()
+		case "s":
+			m.showHistory = !m.showHistory
+			m.updateTableHeight()
+			m.updateColumnWidths()
+			m.updateTableRows()
+			return m, nil
 		}
 
 	case time.Time:
@@ -44,18 +131,66 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		)
 
 	case value_object.DataMsg:
+		var cmd tea.Cmd
 		if msg.Err == nil {
+			m.recordFinishedJobs(msg.Data)
 			m.runners = msg.Data.Runners
 			m.jobs = msg.Data.Jobs
+			m.jobIndex = msg.Data.JobIndex
 			m.currentTime = msg.Data.CurrentTime
+			m.flaggedJobs = msg.Data.FlaggedJobs
+			m.mismatches = msg.Data.Mismatches
+			m.goneRunners = msg.Data.GoneRunners
+			m.churn = msg.Data.Churn
+			m.queueForecasts = msg.Data.QueueForecasts
+			m.usage = msg.Data.Usage
+			m.warnings = msg.Data.Warnings
+			m.pruneMarks()
 			m.lastUpdate = time.Now()
 			m.err = nil
+			m.appendEvents(msg.Data.Events)
+			m.recordHistory()
+			m.updateTableHeight()
 			m.updateTableRows()
+			m.refreshView()
+			cmd = m.notifier.notify(msg.Data.Events)
 		} else {
 			m.err = msg.Err
 		}
 
 		m.loading = false
+		return m, cmd
+
+	case jobLogMsg:
+		return m, m.applyJobLog(msg)
+
+	case clipboardMsg:
+		return m, m.applyClipboardResult(msg)
+
+	case jobLogTickMsg:
+		return m, m.refreshJobLog(msg)
+
+	case eventLogExportedMsg:
+		if msg.err != nil {
+			return m, m.setStatusMessage(msg.err.Error())
+		}
+		return m, m.setStatusMessage(fmt.Sprintf("Exported %d events to %s", msg.count, msg.path))
+
+	case actionResultMsg:
+		status := msg.message
+		if msg.err != nil {
+			status = fmt.Sprintf("Error: %s", firstLine(msg.err))
+		}
+		m.appendEvents([]*value_object.Event{{
+			Type:    value_object.EventAction,
+			At:      m.currentTime,
+			Message: status,
+		}})
+		m.loading = true
+		return m, tea.Batch(m.setStatusMessage(status), m.spinner.Tick, m.fetchData())
+
+	case clearStatusMsg:
+		m.clearStatusMessage(msg.id)
 		return m, nil
 
 	case spinner.TickMsg:
@@ -73,12 +208,50 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, curCmd
 }
 
+// updateEventLog handles key presses while the event log pane has focus
+func (m *Model) updateEventLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "e", "esc":
+		m.toggleEventLog()
+		return m, nil
+	case "x":
+		return m, m.exportEventLog()
+	}
+
+	var cmd tea.Cmd
+	m.eventViewport, cmd = m.eventViewport.Update(msg)
+	return m, cmd
+}
+
+// getJobIndex returns the job index of the current snapshot
+// It is built from the jobs when the snapshot did not carry one
+func (m *Model) getJobIndex() *value_object.JobIndex {
+	if m.jobIndex != nil {
+		return m.jobIndex
+	}
+	return value_object.NewJobIndex(m.runners, m.jobs)
+}
+
 // updateTableRows updates the table with the current runner and job data
 func (m *Model) updateTableRows() {
+	flagged := make(map[int64]*value_object.FlaggedJob, len(m.flaggedJobs))
+	for _, f := range m.flaggedJobs {
+		flagged[f.Job.ID] = f
+	}
+
+	mismatched := make(map[int64]bool, len(m.mismatches))
+	for _, mismatch := range m.mismatches {
+		mismatched[mismatch.Runner.ID] = true
+	}
+
+	index := m.getJobIndex()
 	rows := make([]table.Row, 0, len(m.runners))
 	for _, runner := range m.runners {
 		statusIcon := getStatusIcon(runner.Status)
 		status := fmt.Sprintf("%s %s", statusIcon, runner.Status)
+		if mismatched[runner.ID] {
+			status = fmt.Sprintf("%s %s", status, mismatchIcon)
+		}
 
 		labels := formatLabels(runner.Labels)
 
@@ -86,21 +259,45 @@ func (m *Model) updateTableRows() {
 		execTime := "-"
 
 		// Find active job for this runner
-		for _, job := range m.jobs {
-			if job.IsAssignedToRunner(runner.ID) {
-				jobName = fmt.Sprintf("%s (%s)", job.Name, job.WorkflowName)
-				execTime = formatDuration(job.GetExecutionDurationAt(m.currentTime))
-				break
+		longRunning := false
+		if job := index.ByRunnerID(runner.ID); job != nil {
+			jobName = fmt.Sprintf("%s (%s)", job.Name, job.WorkflowName)
+			if step := formatJobStep(job); step != "" {
+				jobName = fmt.Sprintf("%s · %s", jobName, step)
+			}
+			execTime = formatDuration(job.GetExecutionDurationAt(m.currentTime))
+			if f, ok := flagged[job.ID]; ok {
+				execTime = fmt.Sprintf("%s %s", getFlagIcon(f.Reason), execTime)
+				longRunning = f.Reason == value_object.FlagLongRunning
 			}
 		}
 
-		rows = append(rows, table.Row{
-			runner.Name,
+		// Cells cannot be styled, since the table truncates them without skipping escape sequences,
+		// so the runner of a long-running job is marked with the flag icon to stand out in the first column
+		name := runner.Name
+		if longRunning {
+			name = getFlagIcon(value_object.FlagLongRunning) + " " + name
+		}
+		if m.marked[runner.ID] {
+			name = "● " + name
+		}
+
+		row := table.Row{
+			name,
 			status,
 			labels,
 			jobName,
 			execTime,
-		})
+		}
+		if m.showHistory {
+			var statuses []entity.RunnerStatus
+			if history, ok := m.runnerHistory[runner.ID]; ok {
+				statuses = history.items()
+			}
+			row = append(row, formatStatusStrip(statuses))
+		}
+
+		rows = append(rows, row)
 	}
 	m.table.SetRows(rows)
 }
@@ -108,28 +305,14 @@ func (m *Model) updateTableRows() {
 // openJobLog opens the job log page in the browser for the currently selected row
 func (m *Model) openJobLog() tea.Cmd {
 	return func() tea.Msg {
-		// Get the selected row index
-		selectedRow := m.table.Cursor()
-		if selectedRow < 0 || selectedRow >= len(m.runners) {
-			return nil
-		}
-
-		// Get the runner for the selected row
-		runner := m.runners[selectedRow]
-
-		// Find the active job for this runner
-		var jobURL string
-		for _, job := range m.jobs {
-			if job.IsAssignedToRunner(runner.ID) {
-				jobURL = job.HtmlUrl
-				break
-			}
-		}
+		// Find the active job for the selected runner
+		job := m.selectedJob()
 
 		// If no job URL found, do nothing
-		if jobURL == "" {
+		if job == nil || job.HtmlUrl == "" {
 			return nil
 		}
+		jobURL := job.HtmlUrl
 
 		// Open the URL in the default browser
 		var cmd *exec.Cmd
@@ -168,6 +351,17 @@ func (m *Model) fetchData() tea.Cmd {
 
 // updateColumnWidths adjusts column widths based on terminal width
 func (m *Model) updateColumnWidths() {
-	columns := getCalculatedColumnWidths(m.width)
+	historyWidth := 0
+	if m.showHistory {
+		historyWidth = getHistoryColumnWidth(m.historySize)
+	}
+	columns := getCalculatedColumnWidths(m.width, historyWidth)
 	m.table.SetColumns(columns)
 }
+
+// updateTableHeight adjusts the table height based on terminal height and visible panels
+func (m *Model) updateTableHeight() {
+	m.table.SetHeight(getCalculatedTableHeight(m.height - m.getExtraPanelHeight()))
+	// The titles of the views shown in place of the table take the place of the table header and its border
+	m.viewport.Height = m.table.Height() + 1
+}
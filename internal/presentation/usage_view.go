@@ -0,0 +1,63 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// columnTitleUsage is the title of the first column of the usage view
+const columnTitleUsage = "Repository / Workflow"
+
+// usageView renders the usage in place of the runner table
+func (m *Model) usageView() string {
+	return m.viewportView(fmt.Sprintf("Usage by Repository (%d, heaviest first) | 'w'/'esc' to close, ↑/↓ to scroll",
+		len(m.usage)))
+}
+
+// usageContent renders one aligned line per repository followed by its workflows, indented
+func (m *Model) usageContent() string {
+	if len(m.usage) == 0 {
+		return "No jobs seen yet"
+	}
+
+	nameWidth := len(columnTitleUsage)
+	lineCount := 1
+	for _, repository := range m.usage {
+		nameWidth = max(nameWidth, len([]rune(formatRepositoryName(repository.Repository))))
+		for _, workflow := range repository.Workflows {
+			nameWidth = max(nameWidth, len([]rune(formatWorkflowName(workflow.WorkflowName))))
+		}
+		lineCount += len(repository.Workflows) + 1
+	}
+
+	lines := make([]string, 0, lineCount)
+	lines = append(lines, fmt.Sprintf("%-*s  %7s  %6s  %10s",
+		nameWidth, columnTitleUsage, "Runners", "Queued", "Runner-min"))
+	for _, repository := range m.usage {
+		lines = append(lines, fmt.Sprintf("%-*s  %7d  %6d  %10.1f",
+			nameWidth, formatRepositoryName(repository.Repository),
+			repository.Running, repository.Queued, repository.RunnerTime.Minutes()))
+		for _, workflow := range repository.Workflows {
+			lines = append(lines, fmt.Sprintf("%-*s  %7d  %6d  %10.1f",
+				nameWidth, formatWorkflowName(workflow.WorkflowName),
+				workflow.Running, workflow.Queued, workflow.RunnerTime.Minutes()))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatRepositoryName returns the repository name, or "-" when the API did not report one
+func formatRepositoryName(name string) string {
+	if name == "" {
+		return "-"
+	}
+	return name
+}
+
+// formatWorkflowName indents the workflow name below its repository
+func formatWorkflowName(name string) string {
+	if name == "" {
+		name = "-"
+	}
+	return "  " + name
+}
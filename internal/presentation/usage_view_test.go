@@ -0,0 +1,53 @@
+package presentation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+func TestUsageView(t *testing.T) {
+	data := &value_object.MonitorData{
+		CurrentTime: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Usage: []*value_object.RepositoryUsage{
+			{
+				Repository: "org/app",
+				Running:    3,
+				Queued:     1,
+				RunnerTime: 90 * time.Minute,
+				Workflows: []*value_object.WorkflowUsage{
+					{Repository: "org/app", WorkflowName: "CI", Running: 3, RunnerTime: 90 * time.Minute},
+					{Repository: "org/app", WorkflowName: "Deploy", Queued: 1},
+				},
+			},
+		},
+	}
+	pressW := key("w")
+
+	m := NewModel(nil, "owner", "repo", "", 5, Options{})
+	m.Update(value_object.DataMsg{Data: data})
+
+	m.Update(pressW)
+	view := m.View()
+	if !strings.Contains(view, "Usage by Repository (1, heaviest first)") {
+		t.Errorf("expected the usage title, got %q", view)
+	}
+
+	lines := strings.Split(m.usageContent(), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected a header and 3 usage lines, got %q", lines)
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "org/app 3 1 90.0" {
+		t.Errorf("expected the org/app totals, got %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "  CI ") || !strings.HasPrefix(lines[3], "  Deploy ") {
+		t.Errorf("expected the workflows indented below the repository, got %q", lines[2:])
+	}
+
+	m.Update(pressW)
+	if m.view != viewTable || !m.table.Focused() {
+		t.Error("expected the runner table to be shown again")
+	}
+}
@@ -6,6 +6,20 @@ import (
 	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// stuckJobStyle highlights jobs that exceeded the configured thresholds
+	stuckJobStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)
+	// statusMessageStyle renders transient confirmation messages
+	statusMessageStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	// dialogStyle frames prompts and confirmation dialogs
+	dialogStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("205")).
+			Padding(1, 2)
 )
 
 // View returns the string representation of the model
@@ -25,16 +39,119 @@ func (m *Model) View() string {
 		return header + fmt.Sprintf("\n%s Loading...\n", m.spinner.View())
 	}
 
-	header += fmt.Sprintf("Last Updated: %s | Press 'q' to quit, 'r' to refresh, 'enter' to open job log\n\n",
+	header += fmt.Sprintf("Last Updated: %s | Press 'q' to quit, 'r' to refresh, 'enter' to open job log, '?' for help\n",
 		m.lastUpdate.Format("15:04:05"))
+	if m.replay != nil {
+		header += formatReplayPosition(m.replay.Position()) + "\n"
+	}
+	if m.statusMessage != "" {
+		header += statusMessageStyle.Render(m.statusMessage) + "\n"
+	}
+	if m.showHistory {
+		header += m.fleetUtilizationView() + "\n"
+	}
+	if len(m.flaggedJobs) > 0 {
+		header += stuckJobStyle.Render(m.stuckJobSummary()) + "\n"
+	}
+	if len(m.churn) > 0 {
+		header += m.churnSummary() + "\n"
+	}
+	if len(m.warnings) > 0 {
+		header += fmt.Sprintf("Warning: %v\n", firstLine(m.warnings[len(m.warnings)-1]))
+	}
+	header += "\n"
 
 	if m.err != nil {
 		return header + fmt.Sprintf("\nError: %v\n", m.err)
 	}
 
-	return header + m.table.View()
+	if m.mode != modeNormal {
+		return header + m.dialogView()
+	}
+
+	if m.showHelp {
+		return header + helpView()
+	}
+
+	switch m.view {
+	case viewPools:
+		return header + m.poolView()
+	case viewCapacity:
+		return header + m.capacityView()
+	case viewQueue:
+		return header + m.queueView()
+	case viewUsage:
+		return header + m.usageView()
+	case viewDetail:
+		return header + m.detailView()
+	case viewLog:
+		return header + m.jobLogView()
+	}
+
+	return header + m.table.View() + m.goneRunnerPanelView() + m.eventLogPanelView()
+}
+
+// stuckJobSummary describes how many jobs exceeded the running and queued thresholds
+func (m *Model) stuckJobSummary() string {
+	running := 0
+	queued := 0
+	var longestQueued *value_object.FlaggedJob
+	for _, f := range m.flaggedJobs {
+		switch f.Reason {
+		case value_object.FlagLongRunning:
+			running++
+		case value_object.FlagLongQueued:
+			queued++
+			if longestQueued == nil || f.Duration > longestQueued.Duration {
+				longestQueued = f
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("%s %d long-running job(s) | %s %d long-queued job(s)",
+		getFlagIcon(value_object.FlagLongRunning), running,
+		getFlagIcon(value_object.FlagLongQueued), queued)
+	if longestQueued != nil {
+		summary += fmt.Sprintf(" | longest wait: %s (%s) %s",
+			longestQueued.Job.Name, longestQueued.Job.WorkflowName, formatDuration(longestQueued.Duration))
+	}
+	return summary
+}
+
+// fleetUtilizationView renders the aggregate utilization sparkline of all runners
+func (m *Model) fleetUtilizationView() string {
+	if m.fleetHistory == nil {
+		return "Fleet Utilization: -"
+	}
+	ratios := m.fleetHistory.items()
+	if len(ratios) == 0 {
+		return "Fleet Utilization: -"
+	}
+	current := ratios[len(ratios)-1]
+	return fmt.Sprintf("Fleet Utilization: %s %3.0f%%", formatSparkline(ratios), current*100)
 }
 
+// firstLine returns the first line of an error message so it fits in the header
+func firstLine(err error) string {
+	line, _, _ := strings.Cut(err.Error(), "\n")
+	return line
+}
+
+// getFlagIcon returns the icon marking a job that exceeded a threshold
+func getFlagIcon(reason value_object.FlagReason) string {
+	switch reason {
+	case value_object.FlagLongRunning:
+		return "⏰"
+	case value_object.FlagLongQueued:
+		return "⏳"
+	default:
+		return "⚠"
+	}
+}
+
+// mismatchIcon marks runners whose status reported by the API disagrees with the job data
+const mismatchIcon = "⁉"
+
 // getStatusIcon returns the appropriate icon for the runner status
 func getStatusIcon(status entity.RunnerStatus) string {
 	switch status {
@@ -42,6 +159,8 @@ func getStatusIcon(status entity.RunnerStatus) string {
 		return "🟢"
 	case entity.StatusActive:
 		return "🟠"
+	case entity.StatusBusy:
+		return "🟣"
 	case entity.StatusOffline:
 		return "⚫"
 	default:
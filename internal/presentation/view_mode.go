@@ -0,0 +1,131 @@
+package presentation
+
+import (
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/service"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// viewMode identifies what is shown in place of the runner table
+type viewMode int
+
+const (
+	viewTable viewMode = iota
+	viewPools
+	viewCapacity
+	viewQueue
+	viewUsage
+	viewDetail
+	viewLog
+)
+
+// setView shows the view in place of the runner table, or the runner table for viewTable
+// Every view shares the viewport, which is rendered from the current snapshot and scrolled to the top
+func (m *Model) setView(view viewMode) {
+	if m.view == viewLog {
+		// Drop the log along with the refreshes scheduled for it
+		m.logJob = nil
+		m.logText = ""
+		m.logLines = nil
+		m.logGeneration++
+	}
+	if view != viewPools {
+		m.poolGrouping = value_object.PoolGroupingNone
+	}
+
+	m.view = view
+	if view == viewTable {
+		m.table.Focus()
+		return
+	}
+	m.table.Blur()
+	m.refreshView()
+	m.viewport.GotoTop()
+}
+
+// toggleView shows the view, or returns to the runner table when it is already shown
+func (m *Model) toggleView(view viewMode) {
+	if m.view == view {
+		m.setView(viewTable)
+		return
+	}
+	m.setView(view)
+}
+
+// refreshView renders the shown view from the current snapshot
+// The job log is rendered when its download arrives instead
+func (m *Model) refreshView() {
+	switch m.view {
+	case viewPools:
+		m.pools = service.GroupRunnerPools(m.runners, m.jobs, m.getJobIndex(), m.poolGrouping)
+		m.viewport.SetContent(m.poolContent())
+	case viewCapacity:
+		m.capacities = service.SummarizeLabelCapacity(m.runners, m.jobs, m.currentTime)
+		m.viewport.SetContent(m.capacityContent())
+	case viewQueue:
+		m.viewport.SetContent(m.queueContent())
+	case viewUsage:
+		m.viewport.SetContent(m.usageContent())
+	case viewDetail:
+		m.viewport.SetContent(m.detailContent())
+	}
+}
+
+// updateViewKeys handles key presses while a view is shown in place of the runner table
+// Keys the view does not handle itself close it, show the help, refresh or scroll
+func (m *Model) updateViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	handled := false
+	switch m.view {
+	case viewPools:
+		handled = m.updatePoolKeys(msg.String())
+	case viewCapacity:
+		handled = m.closeViewOn(msg.String(), "L")
+	case viewQueue:
+		handled = m.closeViewOn(msg.String(), "Q")
+	case viewUsage:
+		handled = m.closeViewOn(msg.String(), "w")
+	case viewDetail:
+		cmd, handled = m.updateDetailKeys(msg.String())
+	case viewLog:
+		cmd, handled = m.updateJobLogKeys(msg.String())
+	}
+	if handled {
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.setView(viewTable)
+		return m, nil
+	case "?":
+		m.showHelp = true
+		return m, nil
+	case "r":
+		m.loading = true
+		return m, tea.Batch(m.spinner.Tick, m.fetchData())
+	}
+
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// closeViewOn returns to the runner table if the key is the one that opened the view
+func (m *Model) closeViewOn(key, toggle string) bool {
+	if key != toggle {
+		return false
+	}
+	m.setView(viewTable)
+	return true
+}
+
+// viewportView renders the title of the shown view above the viewport
+func (m *Model) viewportView(title string) string {
+	return title + ":\n" + m.viewport.View()
+}
+
+// newViewport creates the viewport shared by the views shown in place of the runner table
+func newViewport() viewport.Model {
+	return viewport.New(defaultTerminalWidth, getCalculatedTableHeight(defaultTerminalHeight))
+}
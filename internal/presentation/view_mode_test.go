@@ -0,0 +1,80 @@
+package presentation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestViewModes(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-time.Minute)
+	// Enough runners and queued jobs for every view to scroll
+	var runners []*entity.Runner
+	var jobs []*entity.Job
+	for i := range 100 {
+		label := fmt.Sprintf("label-%03d", i+1)
+		runners = append(runners, &entity.Runner{ID: int64(i + 1), Name: fmt.Sprintf("runner-%03d", i+1), Status: entity.StatusOffline, Labels: []string{label}})
+		jobs = append(jobs, &entity.Job{ID: int64(i + 1), Name: "build", Status: "queued", Labels: []string{label}, Repository: fmt.Sprintf("owner/repo-%03d", i+1), CreatedAt: &createdAt})
+	}
+	data := &value_object.MonitorData{
+		CurrentTime:    now,
+		Runners:        runners,
+		Jobs:           jobs,
+		QueueForecasts: make([]*value_object.QueueForecast, 0, len(jobs)),
+		Usage:          make([]*value_object.RepositoryUsage, 0, len(jobs)),
+	}
+	for i, job := range jobs {
+		data.QueueForecasts = append(data.QueueForecasts, &value_object.QueueForecast{Job: job, Position: i})
+		data.Usage = append(data.Usage, &value_object.RepositoryUsage{Repository: job.Repository, Queued: 1})
+	}
+
+	tests := []struct {
+		key      string
+		expected viewMode
+	}{
+		{key: "P", expected: viewPools},
+		{key: "L", expected: viewCapacity},
+		{key: "Q", expected: viewQueue},
+		{key: "w", expected: viewUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			m := NewModel(nil, "owner", "repo", "", 5, Options{})
+			m.loading = false
+			m.Update(value_object.DataMsg{Data: data})
+			press := key(tt.key)
+
+			m.Update(press)
+			if m.view != tt.expected || m.table.Focused() {
+				t.Fatalf("expected view %d in place of the table, got %d", tt.expected, m.view)
+			}
+
+			m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+			if m.viewport.YOffset == 0 {
+				t.Error("expected the view to scroll")
+			}
+
+			m.Update(key("?"))
+			if !m.showHelp {
+				t.Error("expected the help to be shown")
+			}
+			m.Update(press)
+
+			m.Update(key("esc"))
+			if m.view != viewTable || !m.table.Focused() {
+				t.Fatalf("expected the runner table, got view %d", m.view)
+			}
+
+			m.Update(press)
+			if m.viewport.YOffset != 0 {
+				t.Errorf("expected the view to open at the top, got offset %d", m.viewport.YOffset)
+			}
+		})
+	}
+}
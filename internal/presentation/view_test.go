@@ -1,10 +1,12 @@
 package presentation
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
 )
 
 func TestGetStatusIcon(t *testing.T) {
@@ -23,6 +25,11 @@ func TestGetStatusIcon(t *testing.T) {
 			status:   entity.StatusActive,
 			expected: "🟠",
 		},
+		{
+			name:     "busy status",
+			status:   entity.StatusBusy,
+			expected: "🟣",
+		},
 		{
 			name:     "offline status",
 			status:   entity.StatusOffline,
@@ -146,3 +153,61 @@ func TestView(t *testing.T) {
 		}
 	})
 }
+
+func TestStatusMismatchIcon(t *testing.T) {
+	m := NewModel(nil, "owner", "repo", "", 5, Options{})
+	m.runners = []*entity.Runner{
+		{ID: 1, Name: "runner-1", Status: entity.StatusBusy},
+		{ID: 2, Name: "runner-2", Status: entity.StatusIdle},
+	}
+	m.mismatches = []*value_object.StatusMismatch{
+		{Runner: m.runners[0], Reason: value_object.MismatchBusyWithoutJob},
+	}
+	m.updateTableRows()
+
+	rows := m.table.Rows()
+	if !strings.Contains(rows[0][1], mismatchIcon) {
+		t.Errorf("expected mismatch icon for runner-1, got %q", rows[0][1])
+	}
+	if strings.Contains(rows[1][1], mismatchIcon) {
+		t.Errorf("expected no mismatch icon for runner-2, got %q", rows[1][1])
+	}
+}
+
+func TestUpdateTableRows_MarksLongRunningJobs(t *testing.T) {
+	runnerID := int64(2)
+	otherID := int64(3)
+	queuedID := int64(4)
+	startedAt := time.Now().Add(-3 * time.Hour)
+	m := NewModel(nil, "owner", "repo", "", 5, Options{})
+	m.currentTime = time.Now()
+	m.runners = []*entity.Runner{
+		{ID: 1, Name: "runner-1", Status: entity.StatusIdle},
+		{ID: 2, Name: "runner-2", Status: entity.StatusActive},
+		{ID: 3, Name: "runner-3", Status: entity.StatusActive},
+		{ID: 4, Name: "runner-4", Status: entity.StatusIdle},
+	}
+	stuck := &entity.Job{ID: 20, Name: "build", Status: "in_progress", RunnerID: &runnerID, StartedAt: &startedAt}
+	waiting := &entity.Job{ID: 40, Name: "deploy", Status: "queued", RunnerID: &queuedID}
+	m.jobs = []*entity.Job{
+		stuck,
+		{ID: 30, Name: "⏰ nightly", Status: "in_progress", RunnerID: &otherID, StartedAt: &startedAt},
+		waiting,
+	}
+	m.flaggedJobs = []*value_object.FlaggedJob{
+		{Job: stuck, Reason: value_object.FlagLongRunning},
+		{Job: waiting, Reason: value_object.FlagLongQueued},
+	}
+	m.updateTableRows()
+
+	longRunning := getFlagIcon(value_object.FlagLongRunning)
+	for i, expected := range []bool{false, true, false, false} {
+		row := m.table.Rows()[i]
+		if marked := strings.HasPrefix(row[0], longRunning); marked != expected {
+			t.Errorf("expected %s to be marked %v, got %q", m.runners[i].Name, expected, row[0])
+		}
+	}
+	if row := m.table.Rows()[3]; !strings.HasPrefix(row[4], getFlagIcon(value_object.FlagLongQueued)) {
+		t.Errorf("expected the long-queued icon in the time of runner-4, got %q", row[4])
+	}
+}
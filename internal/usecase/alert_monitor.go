@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/service"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// notifyTimeout bounds each notification so that a slow notifier cannot hold a delivery forever
+const notifyTimeout = 30 * time.Second
+
+// AlertMonitor evaluates alert rules on each snapshot and dispatches state changes to notifiers
+// Notifications are delivered in the background, so a slow notifier does not delay the refresh
+type AlertMonitor struct {
+	evaluator  *service.AlertEvaluator
+	deliveries []*alertDelivery
+	timeout    time.Duration
+	wg         sync.WaitGroup
+}
+
+// alertDelivery tracks what was delivered to one notifier
+// A rule whose delivery failed keeps its previous state, so the change is sent again on the next snapshot
+type alertDelivery struct {
+	notifier repository.Notifier
+
+	mu        sync.Mutex
+	delivered map[string]value_object.AlertState
+	inFlight  map[string]bool
+	errs      []error
+}
+
+// NewAlertMonitor creates a new AlertMonitor
+func NewAlertMonitor(rules []value_object.AlertRule, notifiers []repository.Notifier) *AlertMonitor {
+	deliveries := make([]*alertDelivery, 0, len(notifiers))
+	for _, notifier := range notifiers {
+		deliveries = append(deliveries, &alertDelivery{
+			notifier:  notifier,
+			delivered: make(map[string]value_object.AlertState),
+			inFlight:  make(map[string]bool),
+		})
+	}
+	return &AlertMonitor{
+		evaluator:  service.NewAlertEvaluator(rules),
+		deliveries: deliveries,
+		timeout:    notifyTimeout,
+	}
+}
+
+// Observe evaluates the rules and sends every state not yet delivered to each notifier
+// The errors returned are the deliveries that failed since the previous snapshot
+func (a *AlertMonitor) Observe(_ context.Context, data *value_object.MonitorData) error {
+	events := a.evaluator.Evaluate(data)
+
+	var errs []error
+	for _, delivery := range a.deliveries {
+		errs = append(errs, delivery.takeErrors()...)
+		for _, event := range events {
+			a.dispatch(delivery, event)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// dispatch sends the event in the background unless its state was already delivered or is being delivered
+// Rules that never fired are considered resolved, so nothing is sent for them
+func (a *AlertMonitor) dispatch(delivery *alertDelivery, event *value_object.AlertEvent) {
+	name := event.Rule.Name
+
+	delivery.mu.Lock()
+	defer delivery.mu.Unlock()
+	delivered, ok := delivery.delivered[name]
+	if !ok {
+		delivered = value_object.AlertResolved
+	}
+	if delivered == event.State || delivery.inFlight[name] {
+		return
+	}
+	delivery.inFlight[name] = true
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+		defer cancel()
+		err := delivery.notifier.Notify(ctx, event)
+
+		delivery.mu.Lock()
+		defer delivery.mu.Unlock()
+		delete(delivery.inFlight, name)
+		if err != nil {
+			delivery.errs = append(delivery.errs, fmt.Errorf("failed to notify %s: %w", name, err))
+			return
+		}
+		delivery.delivered[name] = event.State
+	}()
+}
+
+// Close waits up to the timeout for the deliveries in progress, so that alerts sent just before exiting are not lost
+// The errors returned are the deliveries that failed and not yet reported, or the timeout
+func (a *AlertMonitor) Close(timeout time.Duration) error {
+	done := make(chan struct{})
+	go func() {
+		a.wait()
+		close(done)
+	}()
+
+	var errs []error
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		errs = append(errs, fmt.Errorf("alert notifications still in progress after %s", timeout))
+	}
+	for _, delivery := range a.deliveries {
+		errs = append(errs, delivery.takeErrors()...)
+	}
+	return errors.Join(errs...)
+}
+
+// wait blocks until the deliveries in progress have finished
+func (a *AlertMonitor) wait() {
+	a.wg.Wait()
+}
+
+// takeErrors returns the failed deliveries and forgets them
+func (d *alertDelivery) takeErrors() []error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	errs := d.errs
+	d.errs = nil
+	return errs
+}
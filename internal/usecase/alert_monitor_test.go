@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+// blockingNotifier blocks every notification until its context is done
+type blockingNotifier struct{}
+
+func (blockingNotifier) Notify(ctx context.Context, _ *value_object.AlertEvent) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestAlertMonitor_Observe(t *testing.T) {
+	rules := []value_object.AlertRule{
+		{Name: "no-idle", Condition: value_object.ConditionRunnerCount, Status: string(entity.StatusIdle), Operator: "==", Value: 0},
+	}
+	busy := &value_object.MonitorData{
+		CurrentTime: time.Now(),
+		Runners:     []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusActive}},
+	}
+	idle := &value_object.MonitorData{
+		CurrentTime: time.Now(),
+		Runners:     []*entity.Runner{{ID: 1, Name: "runner-1", Status: entity.StatusIdle}},
+	}
+	ctx := context.Background()
+
+	t.Run("sends each state change once to every notifier", func(t *testing.T) {
+		first := &test.StubNotifier{}
+		second := &test.StubNotifier{}
+		monitor := NewAlertMonitor(rules, []repository.Notifier{first, second})
+
+		for _, data := range []*value_object.MonitorData{busy, busy, idle, idle} {
+			if err := monitor.Observe(ctx, data); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			monitor.wait()
+		}
+
+		for _, notifier := range []*test.StubNotifier{first, second} {
+			if len(notifier.Events) != 2 ||
+				notifier.Events[0].State != value_object.AlertFiring || notifier.Events[1].State != value_object.AlertResolved {
+				t.Errorf("Expected a firing and a resolved event, got %v", notifier.Events)
+			}
+		}
+	})
+
+	t.Run("retries failed deliveries on the next snapshot", func(t *testing.T) {
+		failing := &test.StubNotifier{NotifyError: errors.New("unreachable")}
+		working := &test.StubNotifier{}
+		monitor := NewAlertMonitor(rules, []repository.Notifier{failing, working})
+
+		_ = monitor.Observe(ctx, busy)
+		monitor.wait()
+		failing.SetNotifyError(nil)
+
+		if err := monitor.Observe(ctx, busy); err == nil {
+			t.Error("Expected the failed delivery to be reported")
+		}
+		monitor.wait()
+		if err := monitor.Observe(ctx, busy); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		monitor.wait()
+
+		if len(failing.Events) != 2 {
+			t.Errorf("Expected the failed notification to be sent again, got %d attempts", len(failing.Events))
+		}
+		if len(working.Events) != 1 {
+			t.Errorf("Expected the delivered notification not to be repeated, got %d", len(working.Events))
+		}
+	})
+
+	t.Run("does not wait for slow notifiers", func(t *testing.T) {
+		monitor := NewAlertMonitor(rules, []repository.Notifier{blockingNotifier{}})
+		monitor.timeout = 50 * time.Millisecond
+
+		start := time.Now()
+		_ = monitor.Observe(ctx, busy)
+		if elapsed := time.Since(start); elapsed >= monitor.timeout {
+			t.Errorf("Expected Observe to return before the notification finished, took %s", elapsed)
+		}
+
+		monitor.wait()
+		if err := monitor.Observe(ctx, busy); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected the timed out delivery to be reported, got %v", err)
+		}
+		monitor.wait()
+	})
+}
+
+func TestAlertMonitor_Close(t *testing.T) {
+	rules := []value_object.AlertRule{
+		{Name: "empty", Condition: value_object.ConditionRunnerCount, Operator: "==", Value: 0},
+	}
+	empty := &value_object.MonitorData{CurrentTime: time.Now()}
+	ctx := context.Background()
+
+	t.Run("waits for the deliveries in progress", func(t *testing.T) {
+		notifier := &test.StubNotifier{}
+		monitor := NewAlertMonitor(rules, []repository.Notifier{notifier})
+
+		if err := monitor.Observe(ctx, empty); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if err := monitor.Close(time.Second); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(notifier.Events) != 1 {
+			t.Errorf("Expected the notification to be delivered before Close returned, got %d", len(notifier.Events))
+		}
+	})
+
+	t.Run("reports failed deliveries", func(t *testing.T) {
+		failing := &test.StubNotifier{NotifyError: errors.New("unreachable")}
+		monitor := NewAlertMonitor(rules, []repository.Notifier{failing})
+
+		_ = monitor.Observe(ctx, empty)
+		if err := monitor.Close(time.Second); err == nil {
+			t.Error("Expected the failed delivery to be reported")
+		}
+	})
+
+	t.Run("gives up after the timeout", func(t *testing.T) {
+		monitor := NewAlertMonitor(rules, []repository.Notifier{blockingNotifier{}})
+		monitor.timeout = time.Second
+
+		_ = monitor.Observe(ctx, empty)
+		start := time.Now()
+		if err := monitor.Close(50 * time.Millisecond); err == nil {
+			t.Error("Expected an error for the delivery still in progress")
+		}
+		if elapsed := time.Since(start); elapsed >= monitor.timeout {
+			t.Errorf("Expected Close to return after its timeout, took %s", elapsed)
+		}
+		monitor.wait()
+	})
+}
+
+func TestRunnerMonitor_Execute_ReportsObserverErrorsAsWarnings(t *testing.T) {
+	failing := &test.StubNotifier{NotifyError: errors.New("unreachable")}
+	alerts := NewAlertMonitor([]value_object.AlertRule{
+		{Name: "empty", Condition: value_object.ConditionRunnerCount, Operator: "==", Value: 0},
+	}, []repository.Notifier{failing})
+	useCase := NewRunnerMonitor(&test.StubRunnerRepository{}, &test.StubJobRepository{}, &test.StubTimeProvider{})
+	useCase.AddObserver(alerts)
+
+	if _, err := useCase.Execute(context.Background(), "owner", "repo", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	alerts.wait()
+
+	// Deliveries run in the background, so their failures are reported with the next snapshot
+	data, err := useCase.Execute(context.Background(), "owner", "repo", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	alerts.wait()
+
+	if len(data.Warnings) != 1 {
+		t.Errorf("Expected 1 warning, got %d", len(data.Warnings))
+	}
+}
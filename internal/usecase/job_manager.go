@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+)
+
+// JobAction identifies an action on a job or its workflow run
+type JobAction string
+
+const (
+	JobActionCancel          JobAction = "cancel"
+	JobActionForceCancel     JobAction = "force-cancel"
+	JobActionRerunFailedJobs JobAction = "rerun-failed-jobs"
+	JobActionRerunJob        JobAction = "rerun-job"
+)
+
+// JobManager handles actions on jobs and workflow runs
+type JobManager struct {
+	jobRepo  repository.JobRepository
+	readOnly bool
+}
+
+// NewJobManager creates a new JobManager
+// When readOnly is true every action fails with ErrReadOnly
+func NewJobManager(jobRepo repository.JobRepository, readOnly bool) *JobManager {
+	return &JobManager{
+		jobRepo:  jobRepo,
+		readOnly: readOnly,
+	}
+}
+
+// IsReadOnly returns true if actions are disabled
+func (u *JobManager) IsReadOnly() bool {
+	return u.readOnly
+}
+
+// Execute performs the action on the job
+// The job's repository is used when known, otherwise owner and repo are used
+func (u *JobManager) Execute(ctx context.Context, action JobAction, owner, repo string, job *entity.Job) error {
+	if u.readOnly {
+		return ErrReadOnly
+	}
+
+	owner, repo = jobRepository(job, owner, repo)
+	if owner == "" || repo == "" {
+		return fmt.Errorf("repository of job %s is unknown", job.Name)
+	}
+
+	switch action {
+	case JobActionCancel:
+		return u.jobRepo.CancelWorkflowRun(ctx, owner, repo, job.RunID)
+	case JobActionForceCancel:
+		return u.jobRepo.ForceCancelWorkflowRun(ctx, owner, repo, job.RunID)
+	case JobActionRerunFailedJobs:
+		return u.jobRepo.RerunFailedJobs(ctx, owner, repo, job.RunID)
+	case JobActionRerunJob:
+		return u.jobRepo.RerunJob(ctx, owner, repo, job.ID)
+	default:
+		return fmt.Errorf("unknown job action %q", action)
+	}
+}
+
+// FetchLog downloads the log of the job
+// Reading a log is allowed in read-only mode
+func (u *JobManager) FetchLog(ctx context.Context, owner, repo string, job *entity.Job) (string, error) {
+	owner, repo = jobRepository(job, owner, repo)
+	if owner == "" || repo == "" {
+		return "", fmt.Errorf("repository of job %s is unknown", job.Name)
+	}
+	return u.jobRepo.FetchJobLog(ctx, owner, repo, job.ID)
+}
+
+// jobRepository returns the owner and name of the repository the job belongs to
+func jobRepository(job *entity.Job, owner, repo string) (string, string) {
+	if jobOwner, jobRepo, ok := strings.Cut(job.Repository, "/"); ok {
+		return jobOwner, jobRepo
+	}
+	return owner, repo
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+func TestJobManager_Execute(t *testing.T) {
+	job := &entity.Job{ID: 10, RunID: 100, Name: "build", Repository: "myorg/service"}
+
+	tests := []struct {
+		name       string
+		action     JobAction
+		wantMethod string
+		wantID     int64
+	}{
+		{name: "cancel", action: JobActionCancel, wantMethod: "CancelWorkflowRun", wantID: 100},
+		{name: "force-cancel", action: JobActionForceCancel, wantMethod: "ForceCancelWorkflowRun", wantID: 100},
+		{name: "rerun failed jobs", action: JobActionRerunFailedJobs, wantMethod: "RerunFailedJobs", wantID: 100},
+		{name: "rerun job", action: JobActionRerunJob, wantMethod: "RerunJob", wantID: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobRepo := &test.StubJobRepository{}
+			manager := NewJobManager(jobRepo, false)
+
+			if err := manager.Execute(context.Background(), tt.action, "owner", "repo", job); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(jobRepo.JobActionCalls) != 1 {
+				t.Fatalf("Expected 1 call, got %d", len(jobRepo.JobActionCalls))
+			}
+			call := jobRepo.JobActionCalls[0]
+			if call.Method != tt.wantMethod || call.ID != tt.wantID {
+				t.Errorf("Expected %s(%d), got %s(%d)", tt.wantMethod, tt.wantID, call.Method, call.ID)
+			}
+		})
+	}
+
+	t.Run("uses the job's repository", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{}
+		manager := NewJobManager(jobRepo, false)
+
+		_ = manager.Execute(context.Background(), JobActionRerunJob, "owner", "repo", job)
+
+		if call := jobRepo.JobActionCalls[0]; call.Owner != "myorg" || call.Repo != "service" {
+			t.Errorf("Expected myorg/service, got %s/%s", call.Owner, call.Repo)
+		}
+	})
+
+	t.Run("falls back to the monitored repository", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{}
+		manager := NewJobManager(jobRepo, false)
+
+		_ = manager.Execute(context.Background(), JobActionRerunJob, "owner", "repo", &entity.Job{ID: 1})
+
+		if call := jobRepo.JobActionCalls[0]; call.Owner != "owner" || call.Repo != "repo" {
+			t.Errorf("Expected owner/repo, got %s/%s", call.Owner, call.Repo)
+		}
+	})
+
+	t.Run("read-only mode rejects actions", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{}
+		manager := NewJobManager(jobRepo, true)
+
+		if err := manager.Execute(context.Background(), JobActionCancel, "owner", "repo", job); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("Expected ErrReadOnly, got %v", err)
+		}
+		if len(jobRepo.JobActionCalls) != 0 {
+			t.Error("Expected repository not to be called in read-only mode")
+		}
+	})
+}
+
+func TestJobManager_FetchLog(t *testing.T) {
+	job := &entity.Job{ID: 100, Name: "build", Repository: "myorg/service"}
+
+	t.Run("downloads the log from the job's repository", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{Log: "hello"}
+		manager := NewJobManager(jobRepo, false)
+
+		log, err := manager.FetchLog(context.Background(), "owner", "repo", job)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if log != "hello" {
+			t.Errorf("Expected the log, got %q", log)
+		}
+		if call := jobRepo.FetchJobLogCalls[0]; call.Owner != "myorg" || call.Repo != "service" || call.ID != 100 {
+			t.Errorf("Expected myorg/service job 100, got %s/%s job %d", call.Owner, call.Repo, call.ID)
+		}
+	})
+
+	t.Run("read-only mode allows reading logs", func(t *testing.T) {
+		jobRepo := &test.StubJobRepository{Log: "hello"}
+		manager := NewJobManager(jobRepo, true)
+
+		if _, err := manager.FetchLog(context.Background(), "owner", "repo", job); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
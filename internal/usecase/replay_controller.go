@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// ReplayController controls playback of a recorded timeline of snapshots
+type ReplayController interface {
+	// TogglePause pauses or resumes playback and returns true if playback is now paused
+	TogglePause() bool
+	// Step moves delta snapshots forward (or backward when negative) and pauses playback
+	Step(delta int)
+	// JumpTo moves to the last snapshot recorded at or before t and pauses playback
+	JumpTo(t time.Time)
+	// Position returns the current playback position
+	Position() value_object.ReplayPosition
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// ErrChecksumMismatch is returned when an archive does not match the advertised checksum
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// RunnerDownloads lists runner application packages and verifies downloaded archives
+type RunnerDownloads struct {
+	downloadRepo repository.DownloadRepository
+}
+
+// NewRunnerDownloads creates a new RunnerDownloads
+func NewRunnerDownloads(downloadRepo repository.DownloadRepository) *RunnerDownloads {
+	return &RunnerDownloads{
+		downloadRepo: downloadRepo,
+	}
+}
+
+// List returns the packages available to the scope
+// Empty os or arch match every package
+func (u *RunnerDownloads) List(ctx context.Context, scope value_object.RunnerScope, os, arch string) ([]*value_object.RunnerDownload, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	downloads, err := u.downloadRepo.FetchRunnerDownloads(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*value_object.RunnerDownload, 0, len(downloads))
+	for _, download := range downloads {
+		if os != "" && !strings.EqualFold(download.OS, os) {
+			continue
+		}
+		if arch != "" && !strings.EqualFold(download.Architecture, arch) {
+			continue
+		}
+		result = append(result, download)
+	}
+	return result, nil
+}
+
+// VerifyArchive checks the archive read from r against the advertised checksums
+// The package with the same file name is used when there is one, otherwise any package with a matching checksum
+func VerifyArchive(downloads []*value_object.RunnerDownload, filename string, r io.Reader) (*value_object.RunnerDownload, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	base := filepath.Base(filename)
+	for _, download := range downloads {
+		if download.Filename != base {
+			continue
+		}
+		if !strings.EqualFold(download.SHA256Checksum, checksum) {
+			return nil, fmt.Errorf("%w: %s has SHA-256 %s, expected %s", ErrChecksumMismatch, base, checksum, download.SHA256Checksum)
+		}
+		return download, nil
+	}
+
+	for _, download := range downloads {
+		if strings.EqualFold(download.SHA256Checksum, checksum) {
+			return download, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s (SHA-256 %s) does not match any advertised runner package", ErrChecksumMismatch, base, checksum)
+}
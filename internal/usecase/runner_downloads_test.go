@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+// helloChecksum is the SHA-256 checksum of "hello"
+const helloChecksum = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestRunnerDownloadsList(t *testing.T) {
+	downloadRepo := &test.StubDownloadRepository{
+		Downloads: []*value_object.RunnerDownload{
+			{OS: "linux", Architecture: "x64", Filename: "linux-x64.tar.gz"},
+			{OS: "linux", Architecture: "arm64", Filename: "linux-arm64.tar.gz"},
+			{OS: "osx", Architecture: "arm64", Filename: "osx-arm64.tar.gz"},
+		},
+	}
+	downloads := NewRunnerDownloads(downloadRepo)
+	scope := value_object.RunnerScope{Org: "my-org"}
+
+	tests := []struct {
+		name     string
+		os       string
+		arch     string
+		expected int
+	}{
+		{name: "no filter", expected: 3},
+		{name: "by os", os: "linux", expected: 2},
+		{name: "by os and arch", os: "Linux", arch: "arm64", expected: 1},
+		{name: "no match", os: "win", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := downloads.List(context.Background(), scope, tt.os, tt.arch)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(result) != tt.expected {
+				t.Errorf("Expected %d downloads, got %d", tt.expected, len(result))
+			}
+		})
+	}
+}
+
+func TestVerifyArchive(t *testing.T) {
+	downloads := []*value_object.RunnerDownload{
+		{OS: "linux", Architecture: "x64", Filename: "linux-x64.tar.gz", SHA256Checksum: "0000"},
+		{OS: "linux", Architecture: "arm64", Filename: "linux-arm64.tar.gz", SHA256Checksum: helloChecksum},
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		expected string
+		wantErr  bool
+	}{
+		{name: "matching file name and checksum", filename: "/tmp/linux-arm64.tar.gz", content: "hello", expected: "linux-arm64.tar.gz"},
+		{name: "matching checksum under another name", filename: "runner.tar.gz", content: "hello", expected: "linux-arm64.tar.gz"},
+		{name: "matching file name with wrong checksum", filename: "linux-x64.tar.gz", content: "hello", wantErr: true},
+		{name: "unknown archive", filename: "runner.tar.gz", content: "bye", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := VerifyArchive(downloads, tt.filename, strings.NewReader(tt.content))
+			if tt.wantErr {
+				if !errors.Is(err, ErrChecksumMismatch) {
+					t.Errorf("Expected ErrChecksumMismatch, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Filename != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result.Filename)
+			}
+		})
+	}
+}
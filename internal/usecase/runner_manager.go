@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+)
+
+// ErrReadOnly is returned by write operations when read-only mode is enabled
+var ErrReadOnly = errors.New("read-only mode is enabled")
+
+// RunnerManager handles write operations on runners
+type RunnerManager struct {
+	runnerRepo repository.RunnerRepository
+	readOnly   bool
+}
+
+// NewRunnerManager creates a new RunnerManager
+// When readOnly is true every write operation fails with ErrReadOnly
+func NewRunnerManager(runnerRepo repository.RunnerRepository, readOnly bool) *RunnerManager {
+	return &RunnerManager{
+		runnerRepo: runnerRepo,
+		readOnly:   readOnly,
+	}
+}
+
+// IsReadOnly returns true if write operations are disabled
+func (u *RunnerManager) IsReadOnly() bool {
+	return u.readOnly
+}
+
+// DeleteRunners removes the runners, continuing past failures and returning all errors
+func (u *RunnerManager) DeleteRunners(ctx context.Context, owner, repo, org string, runners []*entity.Runner) error {
+	return u.forEachRunner(runners, func(runner *entity.Runner) error {
+		return u.runnerRepo.DeleteRunner(ctx, owner, repo, org, runner.ID)
+	})
+}
+
+// AddLabels adds custom labels to the runners
+func (u *RunnerManager) AddLabels(ctx context.Context, owner, repo, org string, runners []*entity.Runner, labels []string) error {
+	return u.forEachRunner(runners, func(runner *entity.Runner) error {
+		return u.runnerRepo.AddLabels(ctx, owner, repo, org, runner.ID, labels)
+	})
+}
+
+// RemoveLabels removes custom labels from the runners
+func (u *RunnerManager) RemoveLabels(ctx context.Context, owner, repo, org string, runners []*entity.Runner, labels []string) error {
+	return u.forEachRunner(runners, func(runner *entity.Runner) error {
+		for _, label := range labels {
+			if err := u.runnerRepo.RemoveLabel(ctx, owner, repo, org, runner.ID, label); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SetLabels replaces the custom labels of the runners
+func (u *RunnerManager) SetLabels(ctx context.Context, owner, repo, org string, runners []*entity.Runner, labels []string) error {
+	return u.forEachRunner(runners, func(runner *entity.Runner) error {
+		return u.runnerRepo.SetLabels(ctx, owner, repo, org, runner.ID, labels)
+	})
+}
+
+// forEachRunner applies action to every runner, continuing past failures and returning all errors
+func (u *RunnerManager) forEachRunner(runners []*entity.Runner, action func(*entity.Runner) error) error {
+	if u.readOnly {
+		return ErrReadOnly
+	}
+
+	var errs []error
+	for _, runner := range runners {
+		if err := action(runner); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", runner.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
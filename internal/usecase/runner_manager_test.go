@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+func TestRunnerManager(t *testing.T) {
+	runners := []*entity.Runner{
+		{ID: 1, Name: "runner-1"},
+		{ID: 2, Name: "runner-2"},
+	}
+	ctx := context.Background()
+
+	t.Run("DeleteRunners deletes every runner", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		manager := NewRunnerManager(runnerRepo, false)
+
+		if err := manager.DeleteRunners(ctx, "owner", "repo", "", runners); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(runnerRepo.DeletedRunnerIDs) != 2 {
+			t.Errorf("Expected 2 deleted runners, got %d", len(runnerRepo.DeletedRunnerIDs))
+		}
+	})
+
+	t.Run("RemoveLabels removes each label", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		manager := NewRunnerManager(runnerRepo, false)
+
+		if err := manager.RemoveLabels(ctx, "owner", "repo", "", runners[:1], []string{"gpu", "x64"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(runnerRepo.LabelCalls) != 2 {
+			t.Fatalf("Expected 2 label calls, got %d", len(runnerRepo.LabelCalls))
+		}
+		if runnerRepo.LabelCalls[1].Method != "RemoveLabel" || runnerRepo.LabelCalls[1].Labels[0] != "x64" {
+			t.Errorf("Unexpected label call %+v", runnerRepo.LabelCalls[1])
+		}
+	})
+
+	t.Run("SetLabels and AddLabels call the repository", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		manager := NewRunnerManager(runnerRepo, false)
+
+		_ = manager.AddLabels(ctx, "owner", "repo", "", runners[:1], []string{"gpu"})
+		_ = manager.SetLabels(ctx, "owner", "repo", "", runners[:1], []string{"linux"})
+
+		if len(runnerRepo.LabelCalls) != 2 {
+			t.Fatalf("Expected 2 label calls, got %d", len(runnerRepo.LabelCalls))
+		}
+		if runnerRepo.LabelCalls[0].Method != "AddLabels" || runnerRepo.LabelCalls[1].Method != "SetLabels" {
+			t.Errorf("Unexpected label calls %+v", runnerRepo.LabelCalls)
+		}
+	})
+
+	t.Run("errors from all runners are returned", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{ManageError: errors.New("forbidden")}
+		manager := NewRunnerManager(runnerRepo, false)
+
+		err := manager.DeleteRunners(ctx, "owner", "repo", "", runners)
+		if err == nil {
+			t.Fatal("Expected error but got none")
+		}
+		if len(runnerRepo.DeletedRunnerIDs) != 2 {
+			t.Errorf("Expected every runner to be attempted, got %d", len(runnerRepo.DeletedRunnerIDs))
+		}
+	})
+
+	t.Run("read-only mode rejects write operations", func(t *testing.T) {
+		runnerRepo := &test.StubRunnerRepository{}
+		manager := NewRunnerManager(runnerRepo, true)
+
+		if !manager.IsReadOnly() {
+			t.Error("Expected IsReadOnly() to be true")
+		}
+		if err := manager.DeleteRunners(ctx, "owner", "repo", "", runners); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("Expected ErrReadOnly, got %v", err)
+		}
+		if err := manager.SetLabels(ctx, "owner", "repo", "", runners, nil); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("Expected ErrReadOnly, got %v", err)
+		}
+		if len(runnerRepo.DeletedRunnerIDs) != 0 || len(runnerRepo.LabelCalls) != 0 {
+			t.Error("Expected repository not to be called in read-only mode")
+		}
+	})
+}
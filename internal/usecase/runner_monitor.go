@@ -2,17 +2,29 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/service"
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
 )
 
 // RunnerMonitor handles the business logic for monitoring runners
+// Execute may be called concurrently; calls are serialised because every snapshot builds on the previous one
 type RunnerMonitor struct {
+	mu           sync.Mutex
 	runnerRepo   repository.RunnerRepository
 	jobRepo      repository.JobRepository
 	timeProvider repository.TimeProvider
+	thresholds   value_object.JobThresholds
+	observers    []SnapshotObserver
+	previous     *value_object.MonitorData
+	lifecycle    *service.RunnerLifecycleTracker
+	forecaster   *service.QueueForecaster
+	usage        *service.UsageTracker
 }
 
 // NewRunnerMonitor creates a new RunnerMonitor
@@ -25,29 +37,88 @@ func NewRunnerMonitor(
 		runnerRepo:   runnerRepo,
 		jobRepo:      jobRepo,
 		timeProvider: timeProvider,
+		thresholds:   value_object.DefaultJobThresholds(),
+		lifecycle:    service.NewRunnerLifecycleTracker(value_object.DefaultGoneRetention),
+		forecaster:   service.NewQueueForecaster(),
+		usage:        service.NewUsageTracker(),
 	}
 }
 
+// SetJobThresholds sets the thresholds used to flag long-running and long-queued jobs
+func (u *RunnerMonitor) SetJobThresholds(thresholds value_object.JobThresholds) {
+	u.thresholds = thresholds
+}
+
+// SetGoneRetention sets how long runners that disappeared are kept in the snapshots
+func (u *RunnerMonitor) SetGoneRetention(retention time.Duration) {
+	u.lifecycle = service.NewRunnerLifecycleTracker(retention)
+}
+
+// AddObserver registers an observer that is notified of every snapshot
+func (u *RunnerMonitor) AddObserver(observer SnapshotObserver) {
+	u.observers = append(u.observers, observer)
+}
+
 // Execute retrieves runners and jobs, and updates runner status
 func (u *RunnerMonitor) Execute(ctx context.Context, owner, repo, org string) (*value_object.MonitorData, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
 	// Fetch runners
 	runners, err := u.runnerRepo.FetchRunners(ctx, owner, repo, org)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch active jobs
+	// Fetch active jobs, the jobs of some runs may be missing
 	jobs, err := u.jobRepo.FetchActiveJobs(ctx, owner, repo, org)
-	if err != nil {
+	var incomplete *repository.IncompleteJobsError
+	if err != nil && !errors.As(err, &incomplete) {
 		return nil, err
 	}
 
+	// Index jobs once so that every consumer can look up the job of a runner directly
+	jobIndex := value_object.NewJobIndex(runners, jobs)
+
+	// Compare the reported status with the jobs before it is overwritten
+	mismatches := service.ReconcileRunnerStatus(runners, jobIndex)
+	reported := make(map[int64]entity.RunnerStatus, len(runners))
+	for _, runner := range runners {
+		reported[runner.ID] = runner.Status
+	}
+
 	// Update runner status based on active jobs
-	service.UpdateRunnerStatus(runners, jobs)
+	service.UpdateRunnerStatus(runners, jobIndex)
+
+	currentTime := u.timeProvider.GetCurrentTime()
 
-	return &value_object.MonitorData{
-		CurrentTime: u.timeProvider.GetCurrentTime(),
+	data := &value_object.MonitorData{
+		CurrentTime: currentTime,
 		Runners:     runners,
 		Jobs:        jobs,
-	}, nil
+		JobIndex:    jobIndex,
+		FlaggedJobs: service.DetectStuckJobs(jobs, currentTime, u.thresholds),
+		Mismatches:  mismatches,
+
+		ReportedStatuses: reported,
+	}
+	if incomplete != nil {
+		data.JobsIncomplete = true
+		data.Warnings = append(data.Warnings, incomplete.Errs...)
+	}
+	data.Events = service.DiffSnapshots(u.previous, data)
+	data.GoneRunners = u.lifecycle.Track(data)
+	data.Churn = service.CountChurnByLabel(data.GoneRunners, u.lifecycle.ChurnWindow(data.CurrentTime))
+	data.QueueForecasts = u.forecaster.Forecast(data)
+	data.Usage = u.usage.Track(data)
+	u.previous = data
+
+	// Observer failures must not interrupt monitoring, so they are surfaced as warnings
+	for _, observer := range u.observers {
+		if err := observer.Observe(ctx, data); err != nil {
+			data.Warnings = append(data.Warnings, err)
+		}
+	}
+
+	return data, nil
 }
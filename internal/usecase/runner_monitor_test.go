@@ -3,10 +3,13 @@ package usecase
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/entity"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
 	"github.com/VeyronSakai/gh-runner-monitor/test"
 )
 
@@ -200,4 +203,138 @@ func TestRunnerMonitor_Execute_UpdatesRunnerStatus(t *testing.T) {
 	if data.Runners[0].Status != entity.StatusActive {
 		t.Errorf("Expected runner status Active, got %s", data.Runners[0].Status)
 	}
+	// The status reported by the repository is kept for observers such as the session recorder
+	if status := data.ReportedStatuses[runnerID]; status != entity.StatusIdle {
+		t.Errorf("Expected reported status Idle, got %s", status)
+	}
+}
+
+func TestRunnerMonitor_Execute_ReportsIncompleteJobsAsWarnings(t *testing.T) {
+	runnerID := int64(1)
+	runnerRepo := &test.StubRunnerRepository{
+		Runners: []*entity.Runner{{ID: runnerID, Name: "runner-1", Status: entity.StatusIdle}},
+	}
+	jobRepo := &test.StubJobRepository{
+		Jobs: []*entity.Job{{ID: 1, RunID: 100, Name: "job-1", Status: "in_progress", RunnerID: &runnerID}},
+	}
+	useCase := NewRunnerMonitor(runnerRepo, jobRepo, &test.StubTimeProvider{})
+	ctx := context.Background()
+
+	if _, err := useCase.Execute(ctx, "owner", "repo", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The run of the job fails to load, so the job is missing but has not finished
+	jobRepo.Jobs = nil
+	jobRepo.GetActiveJobsError = &repository.IncompleteJobsError{Errs: []error{errors.New("failed to fetch jobs of run 100")}}
+	data, err := useCase.Execute(ctx, "owner", "repo", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !data.JobsIncomplete || len(data.Warnings) != 1 {
+		t.Errorf("Expected the snapshot to be marked incomplete with 1 warning, got %v and %v", data.JobsIncomplete, data.Warnings)
+	}
+	for _, event := range data.Events {
+		if event.Type == value_object.EventJobFinished {
+			t.Errorf("Expected no job_finished event for a job that failed to load, got %s", event.Message)
+		}
+	}
+}
+
+func TestRunnerMonitor_Execute_ReportsStatusMismatches(t *testing.T) {
+	runnerRepo := &test.StubRunnerRepository{
+		Runners: []*entity.Runner{
+			{ID: 1, Name: "busy-runner", Status: entity.StatusActive},
+			{ID: 2, Name: "idle-runner", Status: entity.StatusIdle},
+		},
+	}
+	jobRepo := &test.StubJobRepository{}
+	timeProvider := &test.StubTimeProvider{}
+
+	useCase := NewRunnerMonitor(runnerRepo, jobRepo, timeProvider)
+
+	data, err := useCase.Execute(context.Background(), "owner", "repo", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(data.Mismatches) != 1 {
+		t.Fatalf("Expected 1 mismatch, got %d", len(data.Mismatches))
+	}
+	if data.Mismatches[0].Runner.ID != 1 || data.Mismatches[0].Reason != value_object.MismatchBusyWithoutJob {
+		t.Errorf("Expected busy-runner to be busy without job, got %s %s", data.Mismatches[0].Runner.Name, data.Mismatches[0].Reason)
+	}
+	// The runner is busy with a job we cannot see
+	if data.Runners[0].Status != entity.StatusBusy {
+		t.Errorf("Expected runner status Busy, got %s", data.Runners[0].Status)
+	}
+}
+
+func TestRunnerMonitor_Execute_FlagsStuckJobs(t *testing.T) {
+	currentTime := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	startedAt := currentTime.Add(-2 * time.Hour)
+	createdAt := currentTime.Add(-5 * time.Minute)
+
+	jobRepo := &test.StubJobRepository{
+		Jobs: []*entity.Job{
+			{ID: 1, Name: "job-1", Status: "in_progress", StartedAt: &startedAt},
+			{ID: 2, Name: "job-2", Status: "queued", CreatedAt: &createdAt},
+		},
+	}
+	timeProvider := &test.StubTimeProvider{CurrentTime: currentTime}
+
+	useCase := NewRunnerMonitor(&test.StubRunnerRepository{}, jobRepo, timeProvider)
+	useCase.SetJobThresholds(value_object.JobThresholds{
+		LongRunning: time.Hour,
+		LongQueued:  time.Minute,
+	})
+
+	data, err := useCase.Execute(context.Background(), "owner", "repo", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(data.FlaggedJobs) != 2 {
+		t.Fatalf("Expected 2 flagged jobs, got %d", len(data.FlaggedJobs))
+	}
+	if data.FlaggedJobs[0].Reason != value_object.FlagLongRunning {
+		t.Errorf("Expected first job to be flagged as long-running, got %s", data.FlaggedJobs[0].Reason)
+	}
+	if data.FlaggedJobs[1].Reason != value_object.FlagLongQueued {
+		t.Errorf("Expected second job to be flagged as long-queued, got %s", data.FlaggedJobs[1].Reason)
+	}
+}
+
+func TestRunnerMonitor_Execute_Concurrently(t *testing.T) {
+	runnerID := int64(1)
+	startedAt := time.Now()
+	runnerRepo := &test.StubRunnerRepository{
+		Runners: []*entity.Runner{
+			{ID: runnerID, Name: "runner-1", Status: entity.StatusIdle, Labels: []string{"self-hosted"}},
+		},
+	}
+	jobRepo := &test.StubJobRepository{
+		Jobs: []*entity.Job{
+			{ID: 1, RunID: 100, Name: "job-1", Status: "in_progress", RunnerID: &runnerID, StartedAt: &startedAt},
+			{ID: 2, RunID: 101, Name: "job-2", Status: "queued", Labels: []string{"self-hosted"}},
+		},
+	}
+	useCase := NewRunnerMonitor(runnerRepo, jobRepo, &test.StubTimeProvider{})
+	useCase.AddObserver(NewAlertMonitor([]value_object.AlertRule{
+		{Name: "no-idle", Condition: value_object.ConditionRunnerCount, Status: "Idle", Operator: "<", Value: 1},
+	}, nil))
+
+	// Run with -race: the monitor state must not be touched by two refreshes at once
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := useCase.Execute(context.Background(), "owner", "repo", ""); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
 }
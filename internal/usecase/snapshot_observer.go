@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// SnapshotObserver is notified of every snapshot produced by RunnerMonitor
+type SnapshotObserver interface {
+	// Observe processes a snapshot; errors are reported as warnings and do not stop monitoring
+	Observe(ctx context.Context, data *value_object.MonitorData) error
+}
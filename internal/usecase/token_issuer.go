@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/repository"
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// TokenIssuer mints runner registration and remove tokens
+type TokenIssuer struct {
+	tokenRepo repository.TokenRepository
+}
+
+// NewTokenIssuer creates a new TokenIssuer
+func NewTokenIssuer(tokenRepo repository.TokenRepository) *TokenIssuer {
+	return &TokenIssuer{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Issue creates a token of the given kind for the scope
+func (u *TokenIssuer) Issue(ctx context.Context, kind value_object.TokenKind, scope value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case value_object.TokenRegistration:
+		return u.tokenRepo.CreateRegistrationToken(ctx, scope)
+	case value_object.TokenRemove:
+		return u.tokenRepo.CreateRemoveToken(ctx, scope)
+	default:
+		return nil, fmt.Errorf("unknown token kind %q", kind)
+	}
+}
+
+// ConfigCommandOptions holds the optional settings of a config.sh command line
+type ConfigCommandOptions struct {
+	// Name is the runner name, the host name is used by config.sh when empty
+	Name string
+	// Labels are additional custom labels of the runner
+	Labels []string
+	// RunnerGroup is the runner group to add the runner to, organization and enterprise scopes only
+	RunnerGroup string
+}
+
+// ConfigCommand returns a config.sh command line that uses the token
+// The registration runs unattended, and a named runner replaces a registered runner with the same name
+// serverURL is the web URL of the GitHub instance, e.g. https://github.com
+func ConfigCommand(serverURL string, scope value_object.RunnerScope, token *value_object.RunnerToken, opts ConfigCommandOptions) (string, error) {
+	if token.Kind == value_object.TokenRemove {
+		if opts.Name != "" || len(opts.Labels) > 0 || opts.RunnerGroup != "" {
+			return "", fmt.Errorf("name, labels and runner group only apply to registration tokens")
+		}
+		return "./config.sh remove --token " + shellQuote(token.Token), nil
+	}
+	if opts.RunnerGroup != "" && scope.IsRepository() {
+		return "", fmt.Errorf("runner groups are not available for repository runners")
+	}
+
+	args := []string{
+		"./config.sh",
+		"--url", shellQuote(scopeURL(serverURL, scope)),
+		"--token", shellQuote(token.Token),
+		"--unattended",
+	}
+	if opts.Name != "" {
+		args = append(args, "--name", shellQuote(opts.Name), "--replace")
+	}
+	if len(opts.Labels) > 0 {
+		args = append(args, "--labels", shellQuote(strings.Join(opts.Labels, ",")))
+	}
+	if opts.RunnerGroup != "" {
+		args = append(args, "--runnergroup", shellQuote(opts.RunnerGroup))
+	}
+	return strings.Join(args, " "), nil
+}
+
+// scopeURL returns the web URL of the scope that config.sh registers runners with
+func scopeURL(serverURL string, scope value_object.RunnerScope) string {
+	serverURL = strings.TrimSuffix(serverURL, "/")
+	switch {
+	case scope.Enterprise != "":
+		return fmt.Sprintf("%s/enterprises/%s", serverURL, scope.Enterprise)
+	case scope.Org != "":
+		return fmt.Sprintf("%s/%s", serverURL, scope.Org)
+	default:
+		return fmt.Sprintf("%s/%s/%s", serverURL, scope.Owner, scope.Repo)
+	}
+}
+
+// shellQuote quotes s for a POSIX shell unless it only contains safe characters
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_.,:/=@+", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
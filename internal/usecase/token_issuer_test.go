@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+	"github.com/VeyronSakai/gh-runner-monitor/test"
+)
+
+func TestTokenIssuer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("issues a token of the requested kind", func(t *testing.T) {
+		tokenRepo := &test.StubTokenRepository{Token: "ABC"}
+		issuer := NewTokenIssuer(tokenRepo)
+
+		token, err := issuer.Issue(ctx, value_object.TokenRemove, value_object.RunnerScope{Org: "my-org"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if token.Kind != value_object.TokenRemove || token.Token != "ABC" {
+			t.Errorf("Unexpected token %+v", token)
+		}
+		if len(tokenRepo.Scopes) != 1 || tokenRepo.Scopes[0].Org != "my-org" {
+			t.Errorf("Unexpected scopes %+v", tokenRepo.Scopes)
+		}
+	})
+
+	t.Run("rejects ambiguous scopes", func(t *testing.T) {
+		tokenRepo := &test.StubTokenRepository{}
+		issuer := NewTokenIssuer(tokenRepo)
+
+		_, err := issuer.Issue(ctx, value_object.TokenRegistration, value_object.RunnerScope{Org: "my-org", Enterprise: "my-ent"})
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		if len(tokenRepo.Scopes) != 0 {
+			t.Error("Expected the repository not to be called")
+		}
+	})
+}
+
+func TestConfigCommand(t *testing.T) {
+	registration := &value_object.RunnerToken{Kind: value_object.TokenRegistration, Token: "ABC"}
+	remove := &value_object.RunnerToken{Kind: value_object.TokenRemove, Token: "XYZ"}
+
+	tests := []struct {
+		name     string
+		scope    value_object.RunnerScope
+		token    *value_object.RunnerToken
+		opts     ConfigCommandOptions
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "repository",
+			scope:    value_object.RunnerScope{Owner: "owner", Repo: "repo"},
+			token:    registration,
+			expected: "./config.sh --url https://github.com/owner/repo --token ABC --unattended",
+		},
+		{
+			name:     "organization with labels and group",
+			scope:    value_object.RunnerScope{Org: "my-org"},
+			token:    registration,
+			opts:     ConfigCommandOptions{Name: "build 1", Labels: []string{"gpu", "linux"}, RunnerGroup: "Default"},
+			expected: "./config.sh --url https://github.com/my-org --token ABC --unattended --name 'build 1' --replace --labels gpu,linux --runnergroup Default",
+		},
+		{
+			name:     "enterprise",
+			scope:    value_object.RunnerScope{Enterprise: "my-ent"},
+			token:    registration,
+			expected: "./config.sh --url https://github.com/enterprises/my-ent --token ABC --unattended",
+		},
+		{
+			name:     "remove",
+			scope:    value_object.RunnerScope{Org: "my-org"},
+			token:    remove,
+			expected: "./config.sh remove --token XYZ",
+		},
+		{
+			name:    "runner group on a repository",
+			scope:   value_object.RunnerScope{Owner: "owner", Repo: "repo"},
+			token:   registration,
+			opts:    ConfigCommandOptions{RunnerGroup: "Default"},
+			wantErr: true,
+		},
+		{
+			name:    "labels on a remove token",
+			scope:   value_object.RunnerScope{Org: "my-org"},
+			token:   remove,
+			opts:    ConfigCommandOptions{Labels: []string{"gpu"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ConfigCommand("https://github.com/", tt.scope, tt.token, tt.opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %q", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
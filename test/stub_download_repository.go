@@ -0,0 +1,22 @@
+package test
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// StubDownloadRepository is a stub implementation of repository.DownloadRepository for testing.
+type StubDownloadRepository struct {
+	// Downloads is the data that will be returned by FetchRunnerDownloads
+	Downloads []*value_object.RunnerDownload
+	// FetchError is the error that will be returned by FetchRunnerDownloads
+	FetchError error
+}
+
+func (s *StubDownloadRepository) FetchRunnerDownloads(_ context.Context, _ value_object.RunnerScope) ([]*value_object.RunnerDownload, error) {
+	if s.FetchError != nil {
+		return nil, s.FetchError
+	}
+	return s.Downloads, nil
+}
@@ -12,11 +12,56 @@ type StubJobRepository struct {
 	Jobs []*entity.Job
 	// GetActiveJobsError is the error that will be returned by GetActiveJobs
 	GetActiveJobsError error
+	// ActionError is the error that will be returned by the job action methods
+	ActionError error
+	// JobActionCalls records the calls to the job action methods
+	JobActionCalls []JobActionCall
+	// Log is the log that will be returned by FetchJobLog
+	Log string
+	// FetchJobLogError is the error that will be returned by FetchJobLog
+	FetchJobLogError error
+	// FetchJobLogCalls records the calls to FetchJobLog
+	FetchJobLogCalls []JobActionCall
+}
+
+// JobActionCall records a call to one of the job action methods of StubJobRepository
+type JobActionCall struct {
+	Method string
+	Owner  string
+	Repo   string
+	// ID is the workflow run ID, or the job ID for RerunJob
+	ID int64
 }
 
 func (s *StubJobRepository) FetchActiveJobs(_ context.Context, _, _, _ string) ([]*entity.Job, error) {
-	if s.GetActiveJobsError != nil {
-		return nil, s.GetActiveJobsError
+	// The jobs are returned along with the error, like a repository reporting an incomplete result
+	return s.Jobs, s.GetActiveJobsError
+}
+
+func (s *StubJobRepository) CancelWorkflowRun(_ context.Context, owner, repo string, runID int64) error {
+	s.JobActionCalls = append(s.JobActionCalls, JobActionCall{Method: "CancelWorkflowRun", Owner: owner, Repo: repo, ID: runID})
+	return s.ActionError
+}
+
+func (s *StubJobRepository) ForceCancelWorkflowRun(_ context.Context, owner, repo string, runID int64) error {
+	s.JobActionCalls = append(s.JobActionCalls, JobActionCall{Method: "ForceCancelWorkflowRun", Owner: owner, Repo: repo, ID: runID})
+	return s.ActionError
+}
+
+func (s *StubJobRepository) RerunFailedJobs(_ context.Context, owner, repo string, runID int64) error {
+	s.JobActionCalls = append(s.JobActionCalls, JobActionCall{Method: "RerunFailedJobs", Owner: owner, Repo: repo, ID: runID})
+	return s.ActionError
+}
+
+func (s *StubJobRepository) RerunJob(_ context.Context, owner, repo string, jobID int64) error {
+	s.JobActionCalls = append(s.JobActionCalls, JobActionCall{Method: "RerunJob", Owner: owner, Repo: repo, ID: jobID})
+	return s.ActionError
+}
+
+func (s *StubJobRepository) FetchJobLog(_ context.Context, owner, repo string, jobID int64) (string, error) {
+	s.FetchJobLogCalls = append(s.FetchJobLogCalls, JobActionCall{Method: "FetchJobLog", Owner: owner, Repo: repo, ID: jobID})
+	if s.FetchJobLogError != nil {
+		return "", s.FetchJobLogError
 	}
-	return s.Jobs, nil
+	return s.Log, nil
 }
@@ -0,0 +1,33 @@
+package test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// StubNotifier is a stub implementation of repository.Notifier for testing.
+// It records every event it receives and is safe for concurrent use.
+type StubNotifier struct {
+	// Events holds the events passed to Notify
+	Events []*value_object.AlertEvent
+	// NotifyError is the error that will be returned by Notify
+	NotifyError error
+
+	mu sync.Mutex
+}
+
+func (s *StubNotifier) Notify(_ context.Context, event *value_object.AlertEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Events = append(s.Events, event)
+	return s.NotifyError
+}
+
+// SetNotifyError changes the error returned by Notify while deliveries may be running
+func (s *StubNotifier) SetNotifyError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.NotifyError = err
+}
@@ -0,0 +1,36 @@
+package test
+
+import (
+	"time"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// StubReplayController is a stub implementation of usecase.ReplayController for testing.
+type StubReplayController struct {
+	// Current is the position returned by Position
+	Current value_object.ReplayPosition
+	// Steps records the deltas passed to Step
+	Steps []int
+	// Jumps records the times passed to JumpTo
+	Jumps []time.Time
+}
+
+func (s *StubReplayController) TogglePause() bool {
+	s.Current.Paused = !s.Current.Paused
+	return s.Current.Paused
+}
+
+func (s *StubReplayController) Step(delta int) {
+	s.Steps = append(s.Steps, delta)
+	s.Current.Paused = true
+}
+
+func (s *StubReplayController) JumpTo(t time.Time) {
+	s.Jumps = append(s.Jumps, t)
+	s.Current.Paused = true
+}
+
+func (s *StubReplayController) Position() value_object.ReplayPosition {
+	return s.Current
+}
@@ -7,12 +7,25 @@ import (
 )
 
 // StubRunnerRepository is a stub implementation of repository.RunnerRepository for testing.
-// It returns pre-configured responses without any behavior verification.
+// It returns pre-configured responses and records management calls.
 type StubRunnerRepository struct {
 	// Runners is the data that will be returned by GetRunners
 	Runners []*entity.Runner
 	// GetRunnersError is the error that will be returned by GetRunners
 	GetRunnersError error
+	// ManageError is the error that will be returned by the management methods
+	ManageError error
+	// DeletedRunnerIDs records the runners passed to DeleteRunner
+	DeletedRunnerIDs []int64
+	// LabelCalls records the calls to the label methods
+	LabelCalls []LabelCall
+}
+
+// LabelCall records a call to one of the label methods of StubRunnerRepository
+type LabelCall struct {
+	Method   string
+	RunnerID int64
+	Labels   []string
 }
 
 func (s *StubRunnerRepository) FetchRunners(_ context.Context, _, _, _ string) ([]*entity.Runner, error) {
@@ -21,3 +34,23 @@ func (s *StubRunnerRepository) FetchRunners(_ context.Context, _, _, _ string) (
 	}
 	return s.Runners, nil
 }
+
+func (s *StubRunnerRepository) DeleteRunner(_ context.Context, _, _, _ string, runnerID int64) error {
+	s.DeletedRunnerIDs = append(s.DeletedRunnerIDs, runnerID)
+	return s.ManageError
+}
+
+func (s *StubRunnerRepository) AddLabels(_ context.Context, _, _, _ string, runnerID int64, labels []string) error {
+	s.LabelCalls = append(s.LabelCalls, LabelCall{Method: "AddLabels", RunnerID: runnerID, Labels: labels})
+	return s.ManageError
+}
+
+func (s *StubRunnerRepository) RemoveLabel(_ context.Context, _, _, _ string, runnerID int64, label string) error {
+	s.LabelCalls = append(s.LabelCalls, LabelCall{Method: "RemoveLabel", RunnerID: runnerID, Labels: []string{label}})
+	return s.ManageError
+}
+
+func (s *StubRunnerRepository) SetLabels(_ context.Context, _, _, _ string, runnerID int64, labels []string) error {
+	s.LabelCalls = append(s.LabelCalls, LabelCall{Method: "SetLabels", RunnerID: runnerID, Labels: labels})
+	return s.ManageError
+}
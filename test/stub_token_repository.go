@@ -0,0 +1,33 @@
+package test
+
+import (
+	"context"
+
+	"github.com/VeyronSakai/gh-runner-monitor/internal/domain/value_object"
+)
+
+// StubTokenRepository is a stub implementation of repository.TokenRepository for testing.
+type StubTokenRepository struct {
+	// Token is the token value that will be returned
+	Token string
+	// Error is the error that will be returned by every method
+	Error error
+	// Scopes records the scopes passed to the methods
+	Scopes []value_object.RunnerScope
+}
+
+func (s *StubTokenRepository) CreateRegistrationToken(_ context.Context, scope value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	return s.createToken(value_object.TokenRegistration, scope)
+}
+
+func (s *StubTokenRepository) CreateRemoveToken(_ context.Context, scope value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	return s.createToken(value_object.TokenRemove, scope)
+}
+
+func (s *StubTokenRepository) createToken(kind value_object.TokenKind, scope value_object.RunnerScope) (*value_object.RunnerToken, error) {
+	s.Scopes = append(s.Scopes, scope)
+	if s.Error != nil {
+		return nil, s.Error
+	}
+	return &value_object.RunnerToken{Kind: kind, Token: s.Token}, nil
+}